@@ -11,9 +11,16 @@ import (
 	"time"
 
 	"github.com/fatih/color"
+	appachievement "github.com/vinib1903/cineus-api/internal/app/achievement"
 	"github.com/vinib1903/cineus-api/internal/app/auth"
+	appchat "github.com/vinib1903/cineus-api/internal/app/chat"
+	"github.com/vinib1903/cineus-api/internal/app/events"
+	"github.com/vinib1903/cineus-api/internal/app/notification"
 	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	apptheme "github.com/vinib1903/cineus-api/internal/app/theme"
+	appuser "github.com/vinib1903/cineus-api/internal/app/user"
 	"github.com/vinib1903/cineus-api/internal/config"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
 	infraauth "github.com/vinib1903/cineus-api/internal/infra/auth"
 	"github.com/vinib1903/cineus-api/internal/infra/db"
 	"github.com/vinib1903/cineus-api/internal/infra/repo"
@@ -40,27 +47,94 @@ func main() {
 	// Repositories
 	userRepo := repo.NewUserRepository(dbPool)
 	roomRepo := repo.NewRoomRepository(dbPool)
+	banRepo := repo.NewBanRepository(dbPool)
+	memberRepo := repo.NewMemberRepository(dbPool)
+	transferRepo := repo.NewTransferRepository(dbPool)
+	eventRepo := repo.NewEventRepository(dbPool)
+	inviteRepo := repo.NewInviteRepository(dbPool)
+	auditRepo := repo.NewAuditRepository(dbPool)
+	messageRepo := repo.NewMessageRepository(dbPool)
+	directMessageRepo := repo.NewDirectMessageRepository(dbPool)
+	achievementRepo := repo.NewAchievementRepository(dbPool)
+	themeRepo := repo.NewThemeRepository(dbPool)
 
 	// Infrastructure services
 	passwordHasher := infraauth.NewPasswordHasher(10)
 	jwtManager := infraauth.NewJWTManager(cfg.JWT.Secret, cfg.JWT.AccessTokenTTL, cfg.JWT.RefreshTokenTTL)
 	idGenerator := infraauth.NewIDGenerator()
 
+	// Formato dos códigos de acesso
+	err = room.SetAccessCodeFormat(room.AccessCodeFormat{
+		Length:   cfg.Room.AccessCodeLength,
+		Alphabet: cfg.Room.AccessCodeAlphabet,
+	})
+	if err != nil {
+		log.Fatalf("Invalid access code format (ROOM_ACCESS_CODE_LENGTH / ROOM_ACCESS_CODE_ALPHABET): %v", err)
+	}
+
+	// Catálogo de temas
+	themeService := apptheme.NewService(themeRepo, achievementRepo)
+	if err := themeService.Load(ctx); err != nil {
+		log.Fatalf("Failed to load theme catalog: %v", err)
+	}
+	go themeService.RunRefresh(ctx, time.Minute)
+
+	// Event bus
+	eventBus := events.NewBus(1024)
+	go eventBus.Run(ctx)
+
+	// Conexões pessoais (mensagens diretas e notificações)
+	userHub := ws.NewUserHub()
+
+	// Chat das salas e mensagens diretas
+	chatService := appchat.NewService(messageRepo, directMessageRepo, roomRepo, banRepo, memberRepo, userRepo, userHub, idGenerator)
+
+	// WebSocket hub
+	wsHub := ws.NewHub(eventBus, chatService, ws.HubOptions{
+		SpectatorSlots: cfg.Room.SpectatorSlots,
+		MaxSeats:       cfg.Room.MaxSeats,
+		SeatHoldGrace:  time.Duration(cfg.Room.SeatHoldSeconds) * time.Second,
+		IdleTimeout:    time.Duration(cfg.Room.IdleTimeoutSeconds) * time.Second,
+	})
+
 	// Application services
 	authService := auth.NewService(userRepo, passwordHasher, jwtManager, idGenerator)
-	roomService := approom.NewService(roomRepo, idGenerator)
+	notificationService := notification.NewService(userRepo, userHub, wsHub)
+	joinGuard := approom.NewJoinGuard(approom.JoinGuardConfig{
+		AttemptsPerUser: cfg.Room.JoinAttemptsPerUser,
+		AttemptsPerIP:   cfg.Room.JoinAttemptsPerIP,
+		Window:          cfg.Room.JoinAttemptsWindow,
+		AlertFailures:   cfg.Room.JoinFailureAlert,
+	})
+	retention := approom.NewRetention(approom.RetentionConfig{
+		RestoreWindow: cfg.Room.RestoreWindow,
+		PurgeBatch:    cfg.Room.PurgeBatch,
+	})
+	roomService := approom.NewService(roomRepo, banRepo, memberRepo, transferRepo, eventRepo, inviteRepo, auditRepo, userRepo, idGenerator, eventBus, notificationService, wsHub, joinGuard, retention, themeService)
+	wsHub.SetRoomService(roomService)
+	userService := appuser.NewService(userRepo)
+	achievementService := appachievement.NewService(achievementRepo, userRepo, notificationService)
+	eventBus.Subscribe(achievementService.HandleEvent)
 
-	// WebSocket hub
-	wsHub := ws.NewHub()
-	wsHandler := ws.NewHandler(wsHub, roomRepo)
+	// Sessões agendadas (lembretes e início)
+	go roomService.RunScheduler(ctx, 30*time.Second)
+
+	// Limpeza definitiva de salas deletadas
+	go roomService.RunPurge(ctx, cfg.Room.PurgeInterval)
+
+	// WebSocket handler
+	wsHandler := ws.NewHandler(wsHub, userHub, roomRepo, banRepo, memberRepo)
 
 	// HTTP Router
 	router := httpport.NewRouter(httpport.RouterConfig{
-		AuthService: authService,
-		RoomService: roomService,
-		UserRepo:    userRepo,
-		JWTManager:  jwtManager,
-		WSHandler:   wsHandler,
+		AuthService:        authService,
+		RoomService:        roomService,
+		ChatService:        chatService,
+		UserService:        userService,
+		AchievementService: achievementService,
+		ThemeService:       themeService,
+		JWTManager:         jwtManager,
+		WSHandler:          wsHandler,
 	})
 
 	// HTTP Server
@@ -82,6 +156,7 @@ func main() {
 	fmt.Printf("\n-> Server ready on http://localhost:%s\n", cfg.Server.Port)
 	fmt.Printf("-> Health check: http://localhost:%s/health\n", cfg.Server.Port)
 	fmt.Printf("-> WebSocket: ws://localhost:%s/ws/room/{roomId}\n", cfg.Server.Port)
+	fmt.Printf("-> WebSocket (user): ws://localhost:%s/ws/me\n", cfg.Server.Port)
 	fmt.Printf("-> Environment: %s\n\n", cfg.Server.Environment)
 
 	waitForShutdown(server, cancel)
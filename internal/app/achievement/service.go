@@ -0,0 +1,174 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/app/events"
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	"github.com/vinib1903/cineus-api/internal/domain/achievement"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros do serviço de conquistas.
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// Service contém a lógica de progresso e liberação de conquistas.
+type Service struct {
+	achievementRepo achievement.Repository
+	userRepo        user.Repository
+	notifier        *notification.Service
+}
+
+// NewService cria uma nova instância do serviço.
+func NewService(
+	achievementRepo achievement.Repository,
+	userRepo user.Repository,
+	notifier *notification.Service,
+) *Service {
+	return &Service{
+		achievementRepo: achievementRepo,
+		userRepo:        userRepo,
+		notifier:        notifier,
+	}
+}
+
+// UnlockedAchievement é uma conquista do catálogo liberada pelo usuário.
+type UnlockedAchievement struct {
+	Achievement achievement.Achievement
+	UnlockedAt  time.Time
+}
+
+// ListUnlocked retorna as conquistas liberadas por um usuário.
+func (s *Service) ListUnlocked(ctx context.Context, userID user.ID) ([]UnlockedAchievement, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	unlocks, err := s.achievementRepo.ListUnlocked(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]UnlockedAchievement, 0, len(unlocks))
+	for _, u := range unlocks {
+		a, err := achievement.Get(u.AchievementID)
+		if err != nil {
+			// Conquista removida do catálogo
+			continue
+		}
+		result = append(result, UnlockedAchievement{
+			Achievement: a,
+			UnlockedAt:  u.UnlockedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// HandleEvent atualiza o progresso do usuário a partir de um evento de domínio.
+// É inscrito no events.Bus.
+func (s *Service) HandleEvent(ctx context.Context, e events.Event) {
+	if e.UserID.IsEmpty() {
+		return
+	}
+
+	var err error
+	switch e.Type {
+	case events.TypeRoomCreated:
+		if e.Theme == room.ThemeHorror {
+			err = s.progress(ctx, e.UserID, achievement.MetricHorrorRoomsCreated, 1)
+		}
+
+	case events.TypePartyHosted:
+		err = s.progress(ctx, e.UserID, achievement.MetricPartiesHosted, 1)
+
+	case events.TypeWatchSession:
+		if seconds := int64(e.Duration.Seconds()); seconds > 0 {
+			err = s.progress(ctx, e.UserID, achievement.MetricWatchSeconds, seconds)
+		}
+
+	case events.TypeChatMessageSent:
+		err = s.progress(ctx, e.UserID, achievement.MetricChatMessages, 1)
+	}
+
+	if err != nil {
+		log.Printf("Achievements: failed to handle %s for user %s: %v", e.Type, e.UserID, err)
+	}
+}
+
+// progress incrementa uma métrica e libera as conquistas alcançadas.
+// Compara com as conquistas já liberadas (e não só com o limite cruzado agora),
+// assim uma liberação que falhou é refeita no próximo evento da métrica.
+func (s *Service) progress(ctx context.Context, userID user.ID, metric achievement.Metric, amount int64) error {
+	current, err := s.achievementRepo.IncrementProgress(ctx, userID, metric, amount)
+	if err != nil {
+		return err
+	}
+
+	reached := achievement.Reached(metric, current)
+	if len(reached) == 0 {
+		return nil
+	}
+
+	unlocks, err := s.achievementRepo.ListUnlocked(ctx, userID)
+	if err != nil {
+		return err
+	}
+	unlocked := make(map[achievement.ID]bool, len(unlocks))
+	for _, u := range unlocks {
+		unlocked[u.AchievementID] = true
+	}
+
+	for _, a := range reached {
+		if unlocked[a.ID] {
+			continue
+		}
+		if err := s.unlock(ctx, userID, a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unlock registra a conquista, concede o XP e notifica o usuário.
+func (s *Service) unlock(ctx context.Context, userID user.ID, a achievement.Achievement) error {
+	now := time.Now()
+
+	// A conquista e o XP são gravados juntos: se falhar, nada fica pela metade
+	created, totalXP, err := s.achievementRepo.Unlock(ctx, &achievement.Unlock{
+		UserID:        userID,
+		AchievementID: a.ID,
+		UnlockedAt:    now,
+	}, a.XPReward)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return nil // Já liberada anteriormente
+	}
+
+	log.Printf("Achievements: user %s unlocked %s", userID, a.ID)
+
+	return s.notifier.Notify(ctx, userID, notification.Notification{
+		Type:  notification.TypeAchievementUnlocked,
+		Title: "Achievement unlocked: " + a.Name,
+		Body:  a.Description,
+		Data: map[string]interface{}{
+			"achievement_id": a.ID.String(),
+			"name":           a.Name,
+			"xp_reward":      a.XPReward,
+			"total_xp":       totalXP,
+		},
+		CreatedAt: now,
+	})
+}
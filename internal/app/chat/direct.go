@@ -0,0 +1,136 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/app/pagination"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros das mensagens diretas.
+var (
+	ErrRecipientNotFound = errors.New("recipient not found")
+	ErrCannotMessageSelf = errors.New("you cannot send a message to yourself")
+	ErrDirectMessagesOff = errors.New("this user does not accept direct messages")
+)
+
+// Pusher entrega mensagens diretas e confirmações de leitura
+// nas conexões pessoais abertas do usuário.
+type Pusher interface {
+	// PushDirectMessage entrega a mensagem ao destinatário e às outras conexões do remetente.
+	PushDirectMessage(dm *chat.DirectMessage)
+
+	// PushDirectMessagesRead avisa o remetente (e as outras conexões de quem leu)
+	// que a conversa foi lida.
+	PushDirectMessagesRead(senderID, readerID user.ID, readAt time.Time)
+}
+
+// SendDirectMessageInput são os dados de uma mensagem direta.
+type SendDirectMessageInput struct {
+	FromUserID user.ID
+	ToUserID   user.ID
+	Content    string
+}
+
+// SendDirectMessage grava uma mensagem direta e a entrega em tempo real.
+// Ainda não há amizades: quem desativou mensagens de não amigos não recebe nenhuma.
+func (s *Service) SendDirectMessage(ctx context.Context, input SendDirectMessageInput) (*chat.DirectMessage, error) {
+	if input.FromUserID == input.ToUserID {
+		return nil, ErrCannotMessageSelf
+	}
+
+	recipient, err := s.userRepo.GetByID(ctx, input.ToUserID)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrRecipientNotFound
+		}
+		return nil, err
+	}
+
+	if !recipient.Settings.AllowDMsFromNonFriends {
+		return nil, ErrDirectMessagesOff
+	}
+
+	dm, err := chat.NewDirectMessage(chat.DirectMessageID(s.idGen.NewID()), input.FromUserID, input.ToUserID, input.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dmRepo.Create(ctx, dm); err != nil {
+		return nil, err
+	}
+
+	s.pusher.PushDirectMessage(dm)
+
+	return dm, nil
+}
+
+// ListConversationInput são os filtros da conversa entre dois usuários.
+type ListConversationInput struct {
+	UserID      user.ID
+	OtherUserID user.ID
+	Before      string // Cursor opaco: mensagens anteriores a ele (rolagem)
+	Limit       int
+}
+
+// ListConversationOutput é uma página da conversa.
+type ListConversationOutput struct {
+	Messages   []*chat.DirectMessage // Das mais antigas para as mais recentes
+	NextBefore string                // Before da página anterior (vazio quando não há mais)
+}
+
+// ListConversation retorna uma página da conversa com outro usuário.
+func (s *Service) ListConversation(ctx context.Context, input ListConversationInput) (*ListConversationOutput, error) {
+	if input.Limit <= 0 {
+		input.Limit = DefaultHistoryLimit
+	}
+	if input.Limit > MaxHistoryLimit {
+		input.Limit = MaxHistoryLimit
+	}
+
+	var before *chat.Cursor
+	if input.Before != "" {
+		cursor, err := pagination.DecodeTimeCursor(input.Before)
+		if err != nil {
+			return nil, err
+		}
+		before = &chat.Cursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}
+	}
+
+	// Um a mais para saber se existe página anterior
+	messages, err := s.dmRepo.ListConversation(ctx, input.UserID, input.OtherUserID, before, input.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListConversationOutput{}
+	if len(messages) > input.Limit {
+		messages = messages[:input.Limit]
+		oldest := messages[len(messages)-1]
+		output.NextBefore = pagination.EncodeTimeCursor(oldest.CreatedAt, string(oldest.ID))
+	}
+
+	slices.Reverse(messages)
+	output.Messages = messages
+	return output, nil
+}
+
+// MarkConversationRead marca como lidas as mensagens recebidas de outro usuário
+// e avisa o remetente.
+func (s *Service) MarkConversationRead(ctx context.Context, readerID, senderID user.ID) error {
+	if err := s.dmRepo.MarkAsRead(ctx, senderID, readerID); err != nil {
+		return err
+	}
+
+	s.pusher.PushDirectMessagesRead(senderID, readerID, time.Now())
+	return nil
+}
+
+// CountUnread conta as mensagens diretas não lidas do usuário.
+func (s *Service) CountUnread(ctx context.Context, userID user.ID) (int, error) {
+	return s.dmRepo.CountUnread(ctx, userID)
+}
@@ -0,0 +1,178 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/vinib1903/cineus-api/internal/app/pagination"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/infra/auth"
+)
+
+// Erros do serviço de chat.
+var (
+	ErrRoomNotFound  = errors.New("room not found")
+	ErrForbidden     = errors.New("you cannot read the messages of this room")
+	ErrInvalidCursor = pagination.ErrInvalidCursor
+)
+
+// Limites do histórico de mensagens.
+const (
+	DefaultHistoryLimit = 50
+	MaxHistoryLimit     = 100
+)
+
+// Service contém a lógica de negócio do chat.
+type Service struct {
+	messageRepo chat.MessageRepository
+	dmRepo      chat.DirectMessageRepository
+	roomRepo    room.Repository
+	banRepo     room.BanRepository
+	memberRepo  room.MemberRepository
+	userRepo    user.Repository
+	pusher      Pusher
+	idGen       *auth.IDGenerator
+}
+
+// NewService cria uma nova instância do serviço.
+func NewService(
+	messageRepo chat.MessageRepository,
+	dmRepo chat.DirectMessageRepository,
+	roomRepo room.Repository,
+	banRepo room.BanRepository,
+	memberRepo room.MemberRepository,
+	userRepo user.Repository,
+	pusher Pusher,
+	idGen *auth.IDGenerator,
+) *Service {
+	return &Service{
+		messageRepo: messageRepo,
+		dmRepo:      dmRepo,
+		roomRepo:    roomRepo,
+		banRepo:     banRepo,
+		memberRepo:  memberRepo,
+		userRepo:    userRepo,
+		pusher:      pusher,
+		idGen:       idGen,
+	}
+}
+
+// SendRoomMessage valida e grava uma mensagem de sala.
+// Quem chama (RoomHub) já verificou se o usuário pode falar na sala.
+func (s *Service) SendRoomMessage(ctx context.Context, roomID room.ID, userID user.ID, content string) (*chat.Message, error) {
+	msg, err := chat.NewMessage(chat.MessageID(s.idGen.NewID()), roomID, userID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.messageRepo.Create(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// RecentRoomMessages retorna as últimas mensagens da sala, das mais antigas
+// para as mais recentes (ordem de exibição).
+func (s *Service) RecentRoomMessages(ctx context.Context, roomID room.ID, limit int) ([]*chat.Message, error) {
+	messages, err := s.messageRepo.ListByRoom(ctx, roomID, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(messages)
+	return messages, nil
+}
+
+// ListRoomMessagesInput são os filtros do histórico de uma sala.
+type ListRoomMessagesInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	Before      string // Cursor opaco: mensagens anteriores a ele (rolagem)
+	Limit       int
+}
+
+// ListRoomMessagesOutput é uma página do histórico.
+type ListRoomMessagesOutput struct {
+	Messages   []*chat.Message // Das mais antigas para as mais recentes
+	NextBefore string          // Before da página anterior (vazio quando não há mais)
+}
+
+// ListRoomMessages retorna uma página do histórico da sala.
+// Só lê quem poderia entrar na sala.
+func (s *Service) ListRoomMessages(ctx context.Context, input ListRoomMessagesInput) (*ListRoomMessagesOutput, error) {
+	if input.Limit <= 0 {
+		input.Limit = DefaultHistoryLimit
+	}
+	if input.Limit > MaxHistoryLimit {
+		input.Limit = MaxHistoryLimit
+	}
+
+	if err := s.checkReadAccess(ctx, input.RoomID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	var before *chat.Cursor
+	if input.Before != "" {
+		cursor, err := pagination.DecodeTimeCursor(input.Before)
+		if err != nil {
+			return nil, err
+		}
+		before = &chat.Cursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}
+	}
+
+	// Um a mais para saber se existe página anterior
+	messages, err := s.messageRepo.ListByRoom(ctx, input.RoomID, before, input.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListRoomMessagesOutput{}
+	if len(messages) > input.Limit {
+		messages = messages[:input.Limit]
+		oldest := messages[len(messages)-1]
+		output.NextBefore = pagination.EncodeTimeCursor(oldest.CreatedAt, string(oldest.ID))
+	}
+
+	slices.Reverse(messages)
+	output.Messages = messages
+	return output, nil
+}
+
+// checkReadAccess aplica as mesmas regras da entrada na sala: banidos não leem,
+// e salas privadas, com aprovação ou só para membros exigem ser membro.
+func (s *Service) checkReadAccess(ctx context.Context, roomID room.ID, userID user.ID) error {
+	r, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, room.ErrRoomNotFound) {
+			return ErrRoomNotFound
+		}
+		return err
+	}
+
+	if r.IsOwner(userID) {
+		return nil
+	}
+
+	banned, err := s.banRepo.IsUserBanned(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return ErrForbidden
+	}
+
+	if r.IsPrivate() || r.RequiresApproval() || !r.Settings.GuestsAllowed {
+		if _, err := s.memberRepo.Get(ctx, roomID, userID); err != nil {
+			if errors.Is(err, room.ErrMemberNotFound) {
+				return ErrForbidden
+			}
+			return err
+		}
+	}
+
+	return nil
+}
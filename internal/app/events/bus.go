@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Type define os tipos de eventos de domínio.
+type Type string
+
+const (
+	// Emitidos pelo serviço de salas
+	TypeRoomCreated Type = "room.created"
+
+	// Emitidos pelo RoomHub
+	TypePartyHosted  Type = "room.party_hosted"  // Dono recebeu convidados na sessão
+	TypeWatchSession Type = "room.watch_session" // Usuário saiu da sala (Duration = tempo na sala)
+
+	// Emitidos pelo chat
+	TypeChatMessageSent Type = "chat.message_sent"
+)
+
+// Event é um acontecimento de domínio publicado no Bus.
+type Event struct {
+	Type       Type
+	UserID     user.ID
+	RoomID     room.ID
+	Theme      room.Theme
+	Duration   time.Duration
+	OccurredAt time.Time
+}
+
+// New cria um novo evento com o horário atual.
+func New(eventType Type, userID user.ID, roomID room.ID) Event {
+	return Event{
+		Type:       eventType,
+		UserID:     userID,
+		RoomID:     roomID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Publisher é quem publica eventos (serviços e RoomHub dependem só disso).
+type Publisher interface {
+	Publish(e Event)
+}
+
+// Handler processa um evento.
+type Handler func(ctx context.Context, e Event)
+
+// Bus é um barramento de eventos assíncrono em memória.
+// Eventos são processados em ordem por uma única goroutine.
+type Bus struct {
+	queue chan Event
+
+	// Handlers inscritos
+	handlers []Handler
+
+	// Mutex para proteger handlers
+	mu sync.RWMutex
+}
+
+// NewBus cria um novo barramento com uma fila de tamanho buffer.
+func NewBus(buffer int) *Bus {
+	return &Bus{
+		queue: make(chan Event, buffer),
+	}
+}
+
+// Subscribe inscreve um handler para receber todos os eventos.
+func (b *Bus) Subscribe(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+// Publish enfileira um evento sem bloquear.
+// Se a fila estiver cheia, o evento é descartado.
+func (b *Bus) Publish(e Event) {
+	select {
+	case b.queue <- e:
+	default:
+		log.Printf("Events: queue full, dropping %s for user %s", e.Type, e.UserID)
+	}
+}
+
+// Run processa eventos até o contexto ser cancelado.
+func (b *Bus) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case e := <-b.queue:
+			b.dispatch(ctx, e)
+		}
+	}
+}
+
+// dispatch entrega o evento para todos os handlers.
+func (b *Bus) dispatch(ctx context.Context, e Event) {
+	b.mu.RLock()
+	handlers := make([]Handler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(ctx, e)
+	}
+}
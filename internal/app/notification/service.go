@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Type define os tipos de notificação.
+type Type string
+
+const (
+	TypeAchievementUnlocked Type = "achievement_unlocked"
+	TypeRoomTransferOffer   Type = "room_transfer_offer"
+	TypeEventReminder       Type = "event_reminder"
+)
+
+// Notification é uma notificação entregue em tempo real ao usuário.
+type Notification struct {
+	Type      Type
+	Title     string
+	Body      string
+	Data      map[string]interface{}
+	CreatedAt time.Time
+}
+
+// Pusher entrega notificações nas conexões abertas do usuário.
+// Retorna false quando o usuário não tem conexão nesse canal.
+type Pusher interface {
+	PushToUser(userID user.ID, n Notification) bool
+}
+
+// Service contém a lógica de entrega de notificações.
+type Service struct {
+	pushers  []Pusher
+	userRepo user.Repository
+}
+
+// NewService cria uma nova instância do serviço.
+// Os canais são tentados em ordem e a notificação é entregue só no primeiro
+// em que o usuário está conectado (ex: conexões pessoais e, na falta delas, uma sala).
+func NewService(userRepo user.Repository, pushers ...Pusher) *Service {
+	return &Service{
+		pushers:  pushers,
+		userRepo: userRepo,
+	}
+}
+
+// Notify entrega uma notificação para o usuário.
+// Notificações desativadas nas preferências do usuário são descartadas.
+func (s *Service) Notify(ctx context.Context, userID user.ID, n Notification) error {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !wants(u.Settings, n.Type) {
+		return nil
+	}
+
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+
+	for _, pusher := range s.pushers {
+		if pusher.PushToUser(userID, n) {
+			break
+		}
+	}
+	return nil
+}
+
+// wants verifica se as preferências permitem o tipo de notificação.
+func wants(settings user.Settings, notificationType Type) bool {
+	switch notificationType {
+	case TypeAchievementUnlocked:
+		return settings.NotifyAchievements
+	default:
+		return true
+	}
+}
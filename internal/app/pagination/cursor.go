@@ -0,0 +1,59 @@
+// Package pagination contém os cursores opacos usados nas listagens paginadas.
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor indica um cursor malformado ou adulterado.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// TimeCursor identifica o último item de uma página ordenada por (created_at, id).
+type TimeCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// EncodeTimeCursor gera o cursor opaco da ordenação por data.
+func EncodeTimeCursor(createdAt time.Time, id string) string {
+	raw := "t:" + strconv.FormatInt(createdAt.UnixNano(), 10) + ":" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTimeCursor lê o cursor da ordenação por data.
+func DecodeTimeCursor(cursor string) (TimeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), ":", 3)
+	if len(parts) != 3 || parts[0] != "t" || !isUUID(parts[2]) {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+	nanos, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+
+	return TimeCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		ID:        parts[2],
+	}, nil
+}
+
+// isUUID verifica se o ID do cursor está no formato canônico das colunas uuid.
+// Um cursor adulterado não pode chegar ao banco.
+func isUUID(id string) bool {
+	if len(id) != 36 {
+		return false
+	}
+	_, err := uuid.Parse(id)
+	return err == nil
+}
@@ -0,0 +1,118 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/vinib1903/cineus-api/internal/app/pagination"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// ErrInvalidAuditAction indica um filtro de ação desconhecido.
+var ErrInvalidAuditAction = errors.New("invalid audit action")
+
+// Limites da consulta ao log de auditoria.
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 200
+)
+
+// ListAuditInput são os filtros da consulta ao log de auditoria.
+type ListAuditInput struct {
+	RoomID      room.ID // Vazio = todas as salas (apenas em ListAllAudit)
+	RequesterID user.ID
+	Action      room.AuditAction
+	ActorID     user.ID
+	TargetID    user.ID
+	Cursor      string // Retornado em NextCursor da página anterior
+	Limit       int
+}
+
+// ListAuditOutput é uma página do log de auditoria.
+type ListAuditOutput struct {
+	Entries    []*room.AuditEntry
+	NextCursor string // Vazio quando não há mais páginas
+}
+
+// ListAudit retorna o log de auditoria de uma sala.
+// Apenas quem pode ver o log (dono e moderação) tem acesso.
+func (s *Service) ListAudit(ctx context.Context, input ListAuditInput) (*ListAuditOutput, error) {
+	if _, _, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermViewAudit); err != nil {
+		return nil, err
+	}
+
+	return s.listAudit(ctx, input)
+}
+
+// ListAllAudit retorna o log de auditoria de todas as salas.
+// O acesso (admins da plataforma) é verificado na rota.
+func (s *Service) ListAllAudit(ctx context.Context, input ListAuditInput) (*ListAuditOutput, error) {
+	return s.listAudit(ctx, input)
+}
+
+// listAudit valida os filtros e pagina o log.
+func (s *Service) listAudit(ctx context.Context, input ListAuditInput) (*ListAuditOutput, error) {
+	if input.Limit <= 0 {
+		input.Limit = defaultAuditLimit
+	}
+	if input.Limit > maxAuditLimit {
+		input.Limit = maxAuditLimit
+	}
+
+	if input.Action != "" && !room.IsValidAuditAction(input.Action) {
+		return nil, ErrInvalidAuditAction
+	}
+
+	filter := room.AuditFilter{
+		RoomID:   input.RoomID,
+		Action:   input.Action,
+		ActorID:  input.ActorID,
+		TargetID: input.TargetID,
+		Limit:    input.Limit + 1, // Um a mais para saber se existe próxima página
+	}
+	if input.Cursor != "" {
+		cursor, err := pagination.DecodeTimeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.Before = &room.AuditCursor{
+			CreatedAt: cursor.CreatedAt,
+			ID:        room.AuditID(cursor.ID),
+		}
+	}
+
+	entries, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListAuditOutput{}
+	if len(entries) > input.Limit {
+		entries = entries[:input.Limit]
+		last := entries[len(entries)-1]
+		output.NextCursor = pagination.EncodeTimeCursor(last.CreatedAt, string(last.ID))
+	}
+	output.Entries = entries
+
+	return output, nil
+}
+
+// RecordAudit grava uma ação no log de auditoria.
+// Usado pelo RoomHub para as ações feitas ao vivo (kick, mute e unmute).
+func (s *Service) RecordAudit(ctx context.Context, entry *room.AuditEntry) error {
+	if entry.ID == "" {
+		entry.ID = room.AuditID(s.idGen.NewID())
+	}
+
+	return s.auditRepo.Append(ctx, entry)
+}
+
+// recordAudit grava uma ação no log de auditoria.
+// Uma falha só é registrada no log do servidor: a ação em si já aconteceu.
+func (s *Service) recordAudit(ctx context.Context, entry *room.AuditEntry) {
+	if err := s.RecordAudit(ctx, entry); err != nil {
+		log.Printf("Audit: failed to record %s in room %s: %v", entry.Action, entry.RoomID, err)
+	}
+}
@@ -0,0 +1,155 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros de banimento do serviço.
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidBanExpiry = errors.New("ban expiry must be in the future")
+)
+
+// BanInput são os dados para banir um usuário de uma sala.
+type BanInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	UserID      user.ID
+	Reason      string
+	ExpiresAt   *time.Time // nil = permanente
+}
+
+// Ban bane um usuário de uma sala.
+// Só é possível banir quem está abaixo na hierarquia de papéis.
+// Se o usuário estiver conectado na sala, é desconectado na hora.
+func (s *Service) Ban(ctx context.Context, input BanInput) (*room.Ban, error) {
+	r, requesterRole, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermBan)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.UserID == input.RequesterID {
+		return nil, room.ErrCannotBanSelf
+	}
+
+	if r.IsOwner(input.UserID) {
+		return nil, room.ErrCannotBanOwner
+	}
+
+	targetRole, err := s.RoleOf(ctx, r, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !requesterRole.Outranks(targetRole) {
+		return nil, ErrForbidden
+	}
+
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidBanExpiry
+	}
+
+	// Verificar se o usuário existe
+	if _, err := s.userRepo.GetByID(ctx, input.UserID); err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	ban, err := room.NewBan(
+		room.BanID(s.idGen.NewID()),
+		r.ID,
+		input.UserID,
+		input.RequesterID,
+		input.Reason,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.banRepo.Create(ctx, ban); err != nil {
+		return nil, err
+	}
+
+	// Tirar o usuário da sala se estiver conectado
+	s.live.DisconnectBannedUser(ban)
+
+	entry := room.NewAuditEntry(r.ID, room.AuditBan, input.RequesterID, input.UserID, ban.Reason)
+	if ban.ExpiresAt != nil {
+		entry.Details["expires_at"] = ban.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	s.recordAudit(ctx, entry)
+
+	return ban, nil
+}
+
+// ListBans lista os bans ativos de uma sala.
+func (s *Service) ListBans(ctx context.Context, roomID room.ID, requesterID user.ID) ([]*room.Ban, error) {
+	if _, _, err := s.authorize(ctx, roomID, requesterID, room.PermBan); err != nil {
+		return nil, err
+	}
+
+	return s.banRepo.ListByRoom(ctx, roomID)
+}
+
+// UnbanInput são os dados para remover o ban de um usuário.
+type UnbanInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	UserID      user.ID
+}
+
+// Unban remove o ban ativo de um usuário em uma sala.
+// Só quem aplicou o ban ou quem está acima dele na hierarquia pode removê-lo.
+func (s *Service) Unban(ctx context.Context, input UnbanInput) error {
+	r, requesterRole, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermBan)
+	if err != nil {
+		return err
+	}
+
+	ban, err := s.banRepo.GetActiveBan(ctx, input.RoomID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if ban.BannedBy != input.RequesterID {
+		bannerRole, err := s.RoleOf(ctx, r, ban.BannedBy)
+		if err != nil {
+			return err
+		}
+		if !requesterRole.Outranks(bannerRole) {
+			return ErrForbidden
+		}
+	}
+
+	if err := s.banRepo.Delete(ctx, ban.ID); err != nil {
+		return err
+	}
+
+	s.recordAudit(ctx, room.NewAuditEntry(input.RoomID, room.AuditUnban, input.RequesterID, input.UserID, ""))
+	return nil
+}
+
+// IsBanned verifica se o usuário está banido da sala.
+// Usado pelo RoomHub antes de aprovar quem está na sala de espera.
+func (s *Service) IsBanned(ctx context.Context, roomID room.ID, userID user.ID) (bool, error) {
+	return s.banRepo.IsUserBanned(ctx, roomID, userID)
+}
+
+// checkNotBanned retorna room.ErrUserBanned se o usuário estiver banido da sala.
+func (s *Service) checkNotBanned(ctx context.Context, roomID room.ID, userID user.ID) error {
+	banned, err := s.IsBanned(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return room.ErrUserBanned
+	}
+	return nil
+}
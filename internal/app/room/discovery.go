@@ -0,0 +1,276 @@
+package room
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vinib1903/cineus-api/internal/app/pagination"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros da listagem de salas públicas.
+var (
+	ErrInvalidCursor = pagination.ErrInvalidCursor
+	ErrInvalidSort   = errors.New("invalid sort")
+	ErrQueryTooLong  = errors.New("search query too long")
+)
+
+// Ordenações da listagem de salas públicas.
+const (
+	SortNewest  = "newest"
+	SortViewers = "viewers"
+)
+
+// Limites da listagem de salas públicas.
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+	maxQueryLength   = 50
+)
+
+// ListPublicInput são os dados para listar salas públicas.
+type ListPublicInput struct {
+	Limit    int
+	Cursor   string     // Retornado em NextCursor da página anterior
+	Theme    room.Theme // Vazio = todos os temas
+	Query    string     // Busca por parte do nome
+	Sort     string     // newest (padrão) ou viewers
+	Tags     []string   // A sala precisa ter todas
+	Category room.Category
+	Language string
+	Rating   room.ContentRating
+	ViewerID user.ID // Quem está listando (vazio = anônimo)
+}
+
+// PublicRoom é uma sala da listagem com seu estado ao vivo.
+type PublicRoom struct {
+	Room       *room.Room
+	Viewers    int
+	NowPlaying *NowPlaying
+}
+
+// ListPublicOutput é uma página da listagem.
+type ListPublicOutput struct {
+	Rooms      []PublicRoom
+	NextCursor string // Vazio quando não há mais páginas
+}
+
+// ListPublic retorna as salas públicas com a ocupação atual de cada uma.
+//
+// Na ordenação "newest" o cursor aponta para a última sala da página.
+// Na ordenação "viewers" as salas com gente conectada vêm primeiro (mais
+// espectadores antes) e depois as demais, das mais recentes; como a
+// ocupação muda a todo momento, o cursor é apenas a posição na lista.
+func (s *Service) ListPublic(ctx context.Context, input ListPublicInput) (*ListPublicOutput, error) {
+	// Valores padrão
+	if input.Limit <= 0 {
+		input.Limit = defaultListLimit
+	}
+	if input.Limit > maxListLimit {
+		input.Limit = maxListLimit
+	}
+	if input.Sort == "" {
+		input.Sort = SortNewest
+	}
+
+	input.Query = strings.TrimSpace(input.Query)
+	if utf8.RuneCountInString(input.Query) > maxQueryLength {
+		return nil, ErrQueryTooLong
+	}
+	if input.Theme != "" && !room.IsValidTheme(input.Theme) {
+		return nil, room.ErrInvalidTheme
+	}
+
+	if input.Category != "" && !room.IsValidCategory(input.Category) {
+		return nil, room.ErrInvalidCategory
+	}
+	if input.Rating != "" && !room.IsValidContentRating(input.Rating) {
+		return nil, room.ErrInvalidContentRating
+	}
+	tags, err := room.NormalizeTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+	language, err := room.NormalizeLanguage(input.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := room.PublicFilter{
+		Theme:    input.Theme,
+		Query:    input.Query,
+		Tags:     tags,
+		Category: input.Category,
+		Language: language,
+		Rating:   input.Rating,
+	}
+
+	// Respeitar a preferência de esconder salas 18+
+	if input.ViewerID != "" {
+		if filter.HideAdult, err = s.hidesAdultRooms(ctx, input.ViewerID); err != nil {
+			return nil, err
+		}
+	}
+	activity := s.live.Activity()
+
+	switch input.Sort {
+	case SortNewest:
+		return s.listNewest(ctx, filter, input, activity)
+	case SortViewers:
+		return s.listByViewers(ctx, filter, input, activity)
+	default:
+		return nil, ErrInvalidSort
+	}
+}
+
+// hidesAdultRooms verifica se o usuário prefere não ver salas 18+.
+func (s *Service) hidesAdultRooms(ctx context.Context, userID user.ID) (bool, error) {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		// Usuário que não existe mais lista como anônimo
+		if errors.Is(err, user.ErrUserNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return u.Settings.HideAdultRooms, nil
+}
+
+// listNewest pagina as salas por data de criação.
+func (s *Service) listNewest(ctx context.Context, filter room.PublicFilter, input ListPublicInput, activity map[room.ID]RoomActivity) (*ListPublicOutput, error) {
+	if input.Cursor != "" {
+		after, err := pagination.DecodeTimeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = &room.PageCursor{CreatedAt: after.CreatedAt, ID: room.ID(after.ID)}
+	}
+
+	// Busca um a mais para saber se existe próxima página
+	filter.Limit = input.Limit + 1
+	rooms, err := s.roomRepo.ListPublic(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListPublicOutput{}
+	if len(rooms) > input.Limit {
+		rooms = rooms[:input.Limit]
+		last := rooms[len(rooms)-1]
+		output.NextCursor = pagination.EncodeTimeCursor(last.CreatedAt, string(last.ID))
+	}
+	output.Rooms = withActivity(rooms, activity)
+
+	return output, nil
+}
+
+// listByViewers pagina as salas ativas por número de espectadores,
+// seguidas das demais por data de criação.
+func (s *Service) listByViewers(ctx context.Context, filter room.PublicFilter, input ListPublicInput, activity map[room.ID]RoomActivity) (*ListPublicOutput, error) {
+	offset := 0
+	if input.Cursor != "" {
+		var err error
+		if offset, err = decodeOffsetCursor(input.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	liveIDs := make([]room.ID, 0, len(activity))
+	for id, a := range activity {
+		if a.Viewers > 0 {
+			liveIDs = append(liveIDs, id)
+		}
+	}
+
+	// Salas ativas que atendem ao filtro, ordenadas por espectadores
+	var liveRooms []*room.Room
+	if len(liveIDs) > 0 {
+		liveFilter := filter
+		liveFilter.IDs = liveIDs
+		liveFilter.Limit = len(liveIDs)
+
+		var err error
+		liveRooms, err = s.roomRepo.ListPublic(ctx, liveFilter)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(liveRooms, func(i, j int) bool {
+			return activity[liveRooms[i].ID].Viewers > activity[liveRooms[j].ID].Viewers
+		})
+	}
+
+	var page []*room.Room
+	if offset < len(liveRooms) {
+		end := min(offset+input.Limit, len(liveRooms))
+		page = append(page, liveRooms[offset:end]...)
+	}
+
+	// Completa a página com as salas sem ninguém conectado
+	hasMore := offset+len(page) < len(liveRooms)
+	if remaining := input.Limit - len(page); remaining > 0 {
+		idleFilter := filter
+		idleFilter.ExcludeIDs = liveIDs
+		idleFilter.Offset = max(0, offset-len(liveRooms))
+		idleFilter.Limit = remaining + 1
+
+		idle, err := s.roomRepo.ListPublic(ctx, idleFilter)
+		if err != nil {
+			return nil, err
+		}
+		if len(idle) > remaining {
+			idle = idle[:remaining]
+			hasMore = true
+		}
+		page = append(page, idle...)
+	}
+
+	output := &ListPublicOutput{Rooms: withActivity(page, activity)}
+	if hasMore {
+		output.NextCursor = encodeOffsetCursor(offset + input.Limit)
+	}
+
+	return output, nil
+}
+
+// withActivity junta o estado ao vivo de cada sala.
+func withActivity(rooms []*room.Room, activity map[room.ID]RoomActivity) []PublicRoom {
+	result := make([]PublicRoom, len(rooms))
+	for i, rm := range rooms {
+		a := activity[rm.ID]
+		result[i] = PublicRoom{
+			Room:       rm,
+			Viewers:    a.Viewers,
+			NowPlaying: a.NowPlaying,
+		}
+	}
+	return result
+}
+
+// encodeOffsetCursor gera o cursor opaco da ordenação por espectadores.
+func encodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("o:" + strconv.Itoa(offset)))
+}
+
+// decodeOffsetCursor lê o cursor da ordenação por espectadores.
+func decodeOffsetCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	value, ok := strings.CutPrefix(string(raw), "o:")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidCursor
+	}
+	return offset, nil
+}
@@ -0,0 +1,339 @@
+package room
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Constantes das sessões agendadas.
+const (
+	// EventReminderLead é a antecedência do lembrete enviado aos confirmados.
+	EventReminderLead = 15 * time.Minute
+
+	// eventListWindow mantém na listagem as sessões que começaram há pouco.
+	eventListWindow = 6 * time.Hour
+)
+
+// CreateEventInput são os dados para agendar uma sessão.
+type CreateEventInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	Title       string
+	Description string
+	StartsAt    time.Time
+	MediaURL    string
+	MediaTitle  string
+}
+
+// CreateEvent agenda uma sessão na sala. Exige permissão de agendamento.
+func (s *Service) CreateEvent(ctx context.Context, input CreateEventInput) (*room.Event, error) {
+	r, _, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := room.NewEvent(
+		room.EventID(s.idGen.NewID()),
+		r.ID,
+		input.RequesterID,
+		input.Title,
+		input.Description,
+		input.StartsAt,
+		input.MediaURL,
+		input.MediaTitle,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.Create(ctx, e); err != nil {
+		return nil, err
+	}
+
+	// Quem agenda já confirma presença
+	rsvp, err := room.NewRSVP(e.ID, input.RequesterID, room.RSVPGoing)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.eventRepo.SaveRSVP(ctx, rsvp); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// ListEvents lista as próximas sessões da sala (e as que começaram há pouco).
+// Só vê as sessões quem poderia entrar na sala.
+func (s *Service) ListEvents(ctx context.Context, roomID room.ID, requesterID user.ID) ([]*room.Event, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRoomAccess(ctx, r, requesterID); err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.ListByRoom(ctx, roomID, time.Now().Add(-eventListWindow))
+}
+
+// GetEvent busca uma sessão da sala.
+// Só vê a sessão quem poderia entrar na sala.
+func (s *Service) GetEvent(ctx context.Context, roomID room.ID, eventID room.EventID, requesterID user.ID) (*room.Event, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRoomAccess(ctx, r, requesterID); err != nil {
+		return nil, err
+	}
+
+	return s.getEvent(ctx, roomID, eventID)
+}
+
+// getEvent busca uma sessão e confere se ela é da sala.
+func (s *Service) getEvent(ctx context.Context, roomID room.ID, eventID room.EventID) (*room.Event, error) {
+	e, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.RoomID != roomID {
+		return nil, room.ErrEventNotFound
+	}
+
+	return e, nil
+}
+
+// UpdateEventInput são os dados para editar uma sessão.
+// Campos nil não são alterados.
+type UpdateEventInput struct {
+	RoomID      room.ID
+	EventID     room.EventID
+	RequesterID user.ID
+	Title       *string
+	Description *string
+	StartsAt    *time.Time
+	MediaURL    *string
+	MediaTitle  *string
+}
+
+// UpdateEvent edita uma sessão que ainda não começou.
+func (s *Service) UpdateEvent(ctx context.Context, input UpdateEventInput) (*room.Event, error) {
+	e, err := s.getSchedulableEvent(ctx, input.RoomID, input.EventID, input.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.HasStarted() {
+		return nil, room.ErrEventStarted
+	}
+
+	if input.Title != nil || input.Description != nil {
+		title, description := e.Title, e.Description
+		if input.Title != nil {
+			title = *input.Title
+		}
+		if input.Description != nil {
+			description = *input.Description
+		}
+		if err := e.SetDetails(title, description); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.StartsAt != nil {
+		if err := e.Reschedule(*input.StartsAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.MediaURL != nil || input.MediaTitle != nil {
+		mediaURL, mediaTitle := e.MediaURL, e.MediaTitle
+		if input.MediaURL != nil {
+			mediaURL = *input.MediaURL
+		}
+		if input.MediaTitle != nil {
+			mediaTitle = *input.MediaTitle
+		}
+		if err := e.SetMedia(mediaURL, mediaTitle); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.eventRepo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// DeleteEvent remove uma sessão agendada.
+func (s *Service) DeleteEvent(ctx context.Context, roomID room.ID, eventID room.EventID, requesterID user.ID) error {
+	if _, err := s.getSchedulableEvent(ctx, roomID, eventID, requesterID); err != nil {
+		return err
+	}
+
+	return s.eventRepo.Delete(ctx, eventID)
+}
+
+// RSVPInput são os dados da resposta a uma sessão.
+type RSVPInput struct {
+	RoomID  room.ID
+	EventID room.EventID
+	UserID  user.ID
+	Status  room.RSVPStatus
+}
+
+// RSVP registra a resposta do usuário a uma sessão.
+// Só responde quem poderia entrar na sala (banidos incluídos).
+func (s *Service) RSVP(ctx context.Context, input RSVPInput) (*room.RSVP, error) {
+	e, err := s.GetEvent(ctx, input.RoomID, input.EventID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.HasStarted() {
+		return nil, room.ErrEventStarted
+	}
+
+	rsvp, err := room.NewRSVP(e.ID, input.UserID, input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.SaveRSVP(ctx, rsvp); err != nil {
+		return nil, err
+	}
+
+	return rsvp, nil
+}
+
+// CancelRSVP remove a resposta do usuário a uma sessão.
+// Não exige acesso à sala: quem perdeu o acesso ainda pode desistir.
+func (s *Service) CancelRSVP(ctx context.Context, roomID room.ID, eventID room.EventID, userID user.ID) error {
+	if _, err := s.GetByID(ctx, roomID); err != nil {
+		return err
+	}
+
+	if _, err := s.getEvent(ctx, roomID, eventID); err != nil {
+		return err
+	}
+
+	return s.eventRepo.DeleteRSVP(ctx, eventID, userID)
+}
+
+// ListRSVPs lista as respostas a uma sessão.
+func (s *Service) ListRSVPs(ctx context.Context, roomID room.ID, eventID room.EventID, requesterID user.ID) ([]*room.RSVP, error) {
+	if _, err := s.GetEvent(ctx, roomID, eventID, requesterID); err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.ListRSVPs(ctx, eventID)
+}
+
+// RunScheduler envia os lembretes e inicia as sessões agendadas.
+// Verifica a cada interval até o contexto ser cancelado.
+func (s *Service) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sendEventReminders(ctx)
+			s.startDueEvents(ctx)
+		}
+	}
+}
+
+// sendEventReminders avisa os confirmados das sessões que estão para começar.
+func (s *Service) sendEventReminders(ctx context.Context) {
+	due, err := s.eventRepo.ListDueReminders(ctx, time.Now().Add(EventReminderLead))
+	if err != nil {
+		log.Printf("Scheduler: failed to list due reminders: %v", err)
+		return
+	}
+
+	for _, e := range due {
+		// Marcar antes de enviar: se outra instância (ou uma edição) chegou antes, não envia de novo
+		claimed, err := s.eventRepo.MarkReminderSent(ctx, e.ID)
+		if err != nil {
+			log.Printf("Scheduler: failed to mark reminder for event %s: %v", e.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		rsvps, err := s.eventRepo.ListRSVPs(ctx, e.ID)
+		if err != nil {
+			log.Printf("Scheduler: failed to list RSVPs for event %s: %v", e.ID, err)
+			continue
+		}
+
+		for _, rsvp := range rsvps {
+			if rsvp.Status == room.RSVPDeclined {
+				continue
+			}
+
+			err := s.notifier.Notify(ctx, rsvp.UserID, notification.Notification{
+				Type:  notification.TypeEventReminder,
+				Title: e.Title,
+				Body:  "Starting soon",
+				Data: map[string]interface{}{
+					"room_id":   string(e.RoomID),
+					"event_id":  string(e.ID),
+					"starts_at": e.StartsAt,
+				},
+			})
+			if err != nil {
+				log.Printf("Scheduler: failed to notify user %s about event %s: %v", rsvp.UserID, e.ID, err)
+			}
+		}
+	}
+}
+
+// startDueEvents inicia as sessões cujo horário chegou,
+// carregando o vídeo planejado no player da sala.
+func (s *Service) startDueEvents(ctx context.Context) {
+	due, err := s.eventRepo.ListDueStarts(ctx, time.Now())
+	if err != nil {
+		log.Printf("Scheduler: failed to list due events: %v", err)
+		return
+	}
+
+	for _, e := range due {
+		started, err := s.eventRepo.MarkStarted(ctx, e.ID)
+		if err != nil {
+			log.Printf("Scheduler: failed to mark event %s as started: %v", e.ID, err)
+			continue
+		}
+		if !started {
+			continue // Iniciada por outra instância
+		}
+
+		if e.MediaURL != "" {
+			s.live.PreloadMedia(e.RoomID, e.MediaURL, e.MediaTitle)
+		}
+
+		log.Printf("Scheduler: event %s started in room %s", e.ID, e.RoomID)
+	}
+}
+
+// getSchedulableEvent busca a sessão e verifica a permissão de agendamento.
+func (s *Service) getSchedulableEvent(ctx context.Context, roomID room.ID, eventID room.EventID, requesterID user.ID) (*room.Event, error) {
+	if _, _, err := s.authorize(ctx, roomID, requesterID, room.PermSchedule); err != nil {
+		return nil, err
+	}
+
+	return s.getEvent(ctx, roomID, eventID)
+}
@@ -0,0 +1,159 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// CreateInviteInput são os dados para criar um convite.
+type CreateInviteInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	MaxUses     int        // 0 = ilimitado
+	ExpiresAt   *time.Time // nil = não expira
+	SeatID      string     // Assento reservado para quem aceitar (opcional)
+}
+
+// CreateInvite cria um link de convite para a sala. Exige permissão de convite.
+func (s *Service) CreateInvite(ctx context.Context, input CreateInviteInput) (*room.Invite, error) {
+	r, _, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := room.NewInvite(
+		room.InviteID(s.idGen.NewID()),
+		r.ID,
+		input.RequesterID,
+		input.MaxUses,
+		input.ExpiresAt,
+		input.SeatID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// O assento precisa existir no layout da sala e estar livre
+	if invite.SeatID != "" {
+		if !r.SeatLayout().Has(invite.SeatID) {
+			return nil, room.ErrInvalidSeat
+		}
+		if err := s.checkSeatFree(ctx, r.ID, invite.SeatID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// ListInvites lista os convites da sala. Exige permissão de convite.
+func (s *Service) ListInvites(ctx context.Context, roomID room.ID, requesterID user.ID) ([]*room.Invite, error) {
+	if _, _, err := s.authorize(ctx, roomID, requesterID, room.PermInvite); err != nil {
+		return nil, err
+	}
+
+	return s.inviteRepo.ListByRoom(ctx, roomID)
+}
+
+// RevokeInvite invalida um convite da sala. Exige permissão de convite.
+func (s *Service) RevokeInvite(ctx context.Context, roomID room.ID, inviteID room.InviteID, requesterID user.ID) error {
+	if _, _, err := s.authorize(ctx, roomID, requesterID, room.PermInvite); err != nil {
+		return err
+	}
+
+	invite, err := s.inviteRepo.GetByID(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+
+	if invite.RoomID != roomID {
+		return room.ErrInviteNotFound
+	}
+
+	invite.Revoke()
+	return s.inviteRepo.Update(ctx, invite)
+}
+
+// AcceptInvite aceita um convite e torna o usuário membro da sala.
+// Quem já é membro (ou dono) só gasta um uso se o convite reservar um assento.
+func (s *Service) AcceptInvite(ctx context.Context, token string, userID user.ID) (*room.Room, error) {
+	invite, err := s.inviteRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := invite.Validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := s.GetByID(ctx, invite.RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotBanned(ctx, r.ID, userID); err != nil {
+		return nil, err
+	}
+
+	if r.IsOwner(userID) {
+		return r, nil
+	}
+
+	member, err := s.memberRepo.Get(ctx, r.ID, userID)
+	switch {
+	case errors.Is(err, room.ErrMemberNotFound):
+		member, err = room.NewMember(r.ID, userID, room.RoleMember)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case invite.SeatID == "" || invite.SeatID == member.SeatID:
+		// Já é membro e o convite não muda nada
+		return r, nil
+	}
+
+	// O uso do convite e o membro são gravados juntos. Se o assento foi
+	// reservado para outra pessoa depois do convite, o convidado entra sem ele
+	member.SeatID = invite.SeatID
+	member.UpdatedAt = time.Now()
+
+	seatReserved, err := s.inviteRepo.Accept(ctx, invite.ID, member)
+	if err != nil {
+		return nil, err
+	}
+
+	if seatReserved {
+		s.live.ReserveSeat(r.ID, userID, invite.SeatID)
+	}
+
+	return r, nil
+}
+
+// grantMembership garante que o usuário tenha registro de membro na sala.
+// Usado para que quem entrou por código não precise dele de novo.
+func (s *Service) grantMembership(ctx context.Context, r *room.Room, userID user.ID) error {
+	if r.IsOwner(userID) {
+		return nil
+	}
+
+	_, err := s.memberRepo.Get(ctx, r.ID, userID)
+	if !errors.Is(err, room.ErrMemberNotFound) {
+		return err
+	}
+
+	member, err := room.NewMember(r.ID, userID, room.RoleMember)
+	if err != nil {
+		return err
+	}
+
+	return s.memberRepo.Save(ctx, member)
+}
@@ -0,0 +1,61 @@
+package room
+
+import (
+	"log"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/infra/ratelimit"
+)
+
+// JoinGuardConfig são os limites de tentativas de entrada por código.
+type JoinGuardConfig struct {
+	AttemptsPerUser int           // Tentativas por usuário na janela
+	AttemptsPerIP   int           // Tentativas por IP na janela
+	Window          time.Duration // Duração da janela
+	AlertFailures   int           // Falhas (por usuário ou IP) que disparam um alerta
+}
+
+// JoinGuard protege a entrada por código contra tentativa e erro.
+type JoinGuard struct {
+	cfg      JoinGuardConfig
+	byUser   *ratelimit.Limiter
+	byIP     *ratelimit.Limiter
+	failures *ratelimit.Limiter
+}
+
+// NewJoinGuard cria uma nova proteção com os limites informados.
+func NewJoinGuard(cfg JoinGuardConfig) *JoinGuard {
+	return &JoinGuard{
+		cfg:      cfg,
+		byUser:   ratelimit.NewLimiter(cfg.AttemptsPerUser, cfg.Window),
+		byIP:     ratelimit.NewLimiter(cfg.AttemptsPerIP, cfg.Window),
+		failures: ratelimit.NewLimiter(cfg.AlertFailures, cfg.Window),
+	}
+}
+
+// Allow registra uma tentativa e informa se o usuário e o IP ainda estão dentro do limite.
+func (g *JoinGuard) Allow(userID user.ID, clientIP string) bool {
+	if !g.byUser.Allow(string(userID)) {
+		return false
+	}
+	if clientIP != "" && !g.byIP.Allow(clientIP) {
+		return false
+	}
+	return true
+}
+
+// RecordFailure registra um código errado e alerta quando um usuário
+// ou IP atinge o limite de falhas na janela.
+func (g *JoinGuard) RecordFailure(userID user.ID, clientIP string) {
+	if count := g.failures.Count("user:" + string(userID)); count == g.cfg.AlertFailures {
+		log.Printf("SECURITY ALERT: user %s failed %d access code attempts in %v", userID, count, g.cfg.Window)
+	}
+
+	if clientIP == "" {
+		return
+	}
+	if count := g.failures.Count("ip:" + clientIP); count == g.cfg.AlertFailures {
+		log.Printf("SECURITY ALERT: IP %s failed %d access code attempts in %v", clientIP, count, g.cfg.Window)
+	}
+}
@@ -0,0 +1,59 @@
+package room
+
+import (
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// LiveRooms é a visão do serviço sobre as salas ativas em tempo real.
+// Implementado pelo hub de WebSocket; salas sem ninguém conectado são ignoradas.
+type LiveRooms interface {
+	// UpdateRoomInfo propaga nome e tema para os clientes conectados.
+	UpdateRoomInfo(roomID room.ID, name string, theme room.Theme)
+
+	// UpdateRoomSettings aplica as novas configurações e avisa os conectados.
+	UpdateRoomSettings(roomID room.ID, settings room.Settings)
+
+	// DisconnectBannedUser avisa e desconecta o usuário banido, se estiver na sala.
+	DisconnectBannedUser(ban *room.Ban)
+
+	// ClientCount retorna quantos usuários estão conectados na sala.
+	ClientCount(roomID room.ID) int
+
+	// CloseRoom avisa todos os conectados e fecha suas conexões.
+	CloseRoom(roomID room.ID, reason string)
+
+	// CanAdmit verifica se ainda há vaga na sala para o usuário.
+	// Quem já está conectado sempre pode voltar.
+	CanAdmit(roomID room.ID, userID user.ID) bool
+
+	// SetUserRole aplica o novo papel do usuário, se estiver conectado.
+	SetUserRole(roomID room.ID, userID user.ID, role room.Role)
+
+	// ReserveSeat aplica a reserva de assento do usuário (vazio remove).
+	// Se ele estiver conectado, vai para o assento na hora.
+	ReserveSeat(roomID room.ID, userID user.ID, seatID string)
+
+	// TransferOwnership aplica o novo dono e avisa os conectados.
+	TransferOwnership(roomID room.ID, newOwnerID user.ID)
+
+	// PreloadMedia carrega o vídeo no player da sala, pausado no início.
+	// Se ninguém estiver conectado, vale para a próxima abertura da sala.
+	PreloadMedia(roomID room.ID, videoURL, videoTitle string)
+
+	// Activity retorna a ocupação e a mídia atual de todas as salas ativas.
+	Activity() map[room.ID]RoomActivity
+}
+
+// RoomActivity é o estado ao vivo de uma sala.
+type RoomActivity struct {
+	Viewers    int
+	NowPlaying *NowPlaying // nil se nenhum vídeo foi carregado
+}
+
+// NowPlaying é o vídeo carregado em uma sala ativa.
+type NowPlaying struct {
+	VideoURL   string
+	VideoTitle string
+	IsPlaying  bool
+}
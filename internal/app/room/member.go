@@ -0,0 +1,165 @@
+package room
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros de papéis do serviço.
+var (
+	ErrForbidden = errors.New("you do not have permission to do this in this room")
+)
+
+// ListMembers lista o dono e os membros com papel de uma sala.
+// O dono vem sempre primeiro.
+func (s *Service) ListMembers(ctx context.Context, roomID room.ID) ([]*room.Member, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.memberRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := &room.Member{
+		RoomID:    r.ID,
+		UserID:    r.OwnerID,
+		Role:      room.RoleOwner,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+
+	result := []*room.Member{owner}
+	for _, m := range members {
+		// Um membro que recebeu a sala pode ainda ter registro antigo
+		if m.UserID != r.OwnerID {
+			result = append(result, m)
+		}
+	}
+
+	return result, nil
+}
+
+// SetRoleInput são os dados para atribuir um papel a um usuário.
+type SetRoleInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	UserID      user.ID
+	Role        room.Role
+}
+
+// SetRole atribui um papel a um usuário da sala. Apenas o dono pode fazer isso.
+// Se o usuário estiver conectado, as novas permissões valem na hora.
+func (s *Service) SetRole(ctx context.Context, input SetRoleInput) (*room.Member, error) {
+	r, err := s.getOwnedRoom(ctx, input.RoomID, input.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.IsOwner(input.UserID) {
+		return nil, room.ErrCannotAssignOwner
+	}
+
+	// Verificar se o usuário existe
+	if _, err := s.userRepo.GetByID(ctx, input.UserID); err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	previousRole := room.RoleMember
+	member, err := s.memberRepo.Get(ctx, r.ID, input.UserID)
+	switch {
+	case errors.Is(err, room.ErrMemberNotFound):
+		member, err = room.NewMember(r.ID, input.UserID, input.Role)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		previousRole = member.Role
+		if err := member.ChangeRole(input.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.memberRepo.Save(ctx, member); err != nil {
+		return nil, err
+	}
+
+	s.live.SetUserRole(r.ID, member.UserID, member.Role)
+
+	entry := room.NewAuditEntry(r.ID, room.AuditRoleChange, input.RequesterID, member.UserID, "")
+	entry.Details["previous_role"] = string(previousRole)
+	entry.Details["role"] = string(member.Role)
+	s.recordAudit(ctx, entry)
+
+	return member, nil
+}
+
+// RoleOf retorna o papel de um usuário em uma sala.
+func (s *Service) RoleOf(ctx context.Context, r *room.Room, userID user.ID) (room.Role, error) {
+	if r.IsOwner(userID) {
+		return room.RoleOwner, nil
+	}
+
+	member, err := s.memberRepo.Get(ctx, r.ID, userID)
+	if err != nil {
+		if errors.Is(err, room.ErrMemberNotFound) {
+			return room.RoleMember, nil
+		}
+		return "", err
+	}
+
+	return r.RoleOf(userID, member), nil
+}
+
+// checkRoomAccess aplica as mesmas regras da entrada na sala: banidos não veem nada,
+// e salas privadas, com aprovação ou só para membros exigem ser membro.
+func (s *Service) checkRoomAccess(ctx context.Context, r *room.Room, userID user.ID) error {
+	if r.IsOwner(userID) {
+		return nil
+	}
+
+	if err := s.checkNotBanned(ctx, r.ID, userID); err != nil {
+		return err
+	}
+
+	if r.IsPrivate() || r.RequiresApproval() || !r.Settings.GuestsAllowed {
+		if _, err := s.memberRepo.Get(ctx, r.ID, userID); err != nil {
+			if errors.Is(err, room.ErrMemberNotFound) {
+				return ErrForbidden
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorize busca a sala e verifica se o usuário tem a permissão.
+// Retorna a sala e o papel do usuário.
+func (s *Service) authorize(ctx context.Context, roomID room.ID, userID user.ID, perm room.Permission) (*room.Room, room.Role, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	role, err := s.RoleOf(ctx, r, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !role.Can(perm) {
+		return nil, "", ErrForbidden
+	}
+
+	return r, role, nil
+}
@@ -0,0 +1,162 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// RetentionConfig define o ciclo de vida das salas deletadas.
+type RetentionConfig struct {
+	RestoreWindow time.Duration // Prazo para o dono restaurar a sala
+	PurgeBatch    int           // Salas apagadas de vez por lote
+}
+
+// Retention guarda a política de retenção e as métricas da limpeza.
+type Retention struct {
+	cfg RetentionConfig
+
+	mu    sync.Mutex
+	stats PurgeStats
+}
+
+// PurgeStats são as métricas acumuladas da limpeza de salas deletadas.
+type PurgeStats struct {
+	Runs      int64
+	Failures  int64
+	LastRunAt *time.Time
+	Removed   room.PurgeResult // Total apagado desde que o servidor subiu
+	LastRun   room.PurgeResult // Apagado na última execução
+}
+
+// NewRetention cria uma nova política de retenção.
+func NewRetention(cfg RetentionConfig) *Retention {
+	if cfg.PurgeBatch <= 0 {
+		cfg.PurgeBatch = 100
+	}
+	return &Retention{cfg: cfg}
+}
+
+// record soma o resultado de uma execução às métricas.
+func (r *Retention) record(result room.PurgeResult, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.stats.Runs++
+	r.stats.LastRunAt = &now
+	r.stats.LastRun = result
+	r.stats.Removed.Add(result)
+	if err != nil {
+		r.stats.Failures++
+	}
+}
+
+// Stats retorna uma cópia das métricas da limpeza.
+func (r *Retention) Stats() PurgeStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// ListDeletedRooms lista as salas do usuário que ainda podem ser restauradas.
+func (s *Service) ListDeletedRooms(ctx context.Context, ownerID user.ID) ([]*room.Room, error) {
+	return s.roomRepo.ListDeletedByOwner(ctx, ownerID, time.Now().Add(-s.retention.cfg.RestoreWindow))
+}
+
+// RestoreDeadline retorna até quando uma sala deletada pode ser restaurada.
+func (s *Service) RestoreDeadline(r *room.Room) *time.Time {
+	if r.DeletedAt == nil {
+		return nil
+	}
+	deadline := r.DeletedAt.Add(s.retention.cfg.RestoreWindow)
+	return &deadline
+}
+
+// RestoreRoom desfaz a deleção de uma sala dentro do prazo de retenção.
+// Respeita o limite de salas por usuário.
+func (s *Service) RestoreRoom(ctx context.Context, roomID room.ID, requesterID user.ID) (*room.Room, error) {
+	r, err := s.roomRepo.GetDeletedByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, room.ErrRoomNotFound) {
+			return nil, ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	if !r.IsOwner(requesterID) {
+		return nil, ErrNotRoomOwner
+	}
+
+	count, err := s.roomRepo.CountByOwner(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxRoomsPerUser {
+		return nil, ErrMaxRoomsReached
+	}
+
+	if err := r.Restore(requesterID, s.retention.cfg.RestoreWindow); err != nil {
+		return nil, err
+	}
+
+	if err := s.roomRepo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// PurgeStats retorna as métricas da limpeza de salas deletadas.
+func (s *Service) PurgeStats() PurgeStats {
+	return s.retention.Stats()
+}
+
+// RunPurge apaga de vez as salas deletadas há mais tempo que o prazo de restauração.
+// Executa a cada interval até o contexto ser cancelado.
+func (s *Service) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.purgeExpiredRooms(ctx)
+		}
+	}
+}
+
+// purgeExpiredRooms apaga as salas vencidas em lotes até não sobrar nenhuma.
+func (s *Service) purgeExpiredRooms(ctx context.Context) {
+	before := time.Now().Add(-s.retention.cfg.RestoreWindow)
+
+	var total room.PurgeResult
+	var err error
+	for ctx.Err() == nil {
+		var batch room.PurgeResult
+		batch, err = s.roomRepo.PurgeDeleted(ctx, before, s.retention.cfg.PurgeBatch)
+		if err != nil {
+			log.Printf("Purge: failed to purge deleted rooms: %v", err)
+			break
+		}
+
+		total.Add(batch)
+		if batch.Rooms < int64(s.retention.cfg.PurgeBatch) {
+			break
+		}
+	}
+
+	s.retention.record(total, err)
+
+	if total.Rooms > 0 {
+		log.Printf("Purge: removed %d rooms (%d chat messages, %d bans, %d invites, %d members, %d events)",
+			total.Rooms, total.ChatMessages, total.Bans, total.Invites, total.Members, total.Events)
+	}
+}
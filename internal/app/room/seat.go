@@ -0,0 +1,96 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros de reserva de assentos.
+var (
+	ErrSeatReserved  = errors.New("seat is reserved for another member")
+	ErrOwnerSeatPick = errors.New("the owner picks their own seat")
+)
+
+// AssignSeatInput são os dados para reservar um assento para um usuário.
+type AssignSeatInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	UserID      user.ID
+	SeatID      string // Vazio remove a reserva
+}
+
+// AssignSeat reserva um assento da sala para um usuário. Exige permissão de assentos.
+// Se o usuário estiver conectado, ele vai para o assento na hora.
+func (s *Service) AssignSeat(ctx context.Context, input AssignSeatInput) (*room.Member, error) {
+	r, _, err := s.authorize(ctx, input.RoomID, input.RequesterID, room.PermManageSeats)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.IsOwner(input.UserID) {
+		return nil, ErrOwnerSeatPick
+	}
+
+	seatID := room.NormalizeSeatID(input.SeatID)
+	if seatID != "" {
+		if !r.SeatLayout().Has(seatID) {
+			return nil, room.ErrInvalidSeat
+		}
+		if err := s.checkSeatFree(ctx, r.ID, seatID, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Verificar se o usuário existe
+	if _, err := s.userRepo.GetByID(ctx, input.UserID); err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	member, err := s.memberRepo.Get(ctx, r.ID, input.UserID)
+	switch {
+	case errors.Is(err, room.ErrMemberNotFound):
+		member, err = room.NewMember(r.ID, input.UserID, room.RoleMember)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	member.SeatID = seatID
+	member.UpdatedAt = time.Now()
+
+	// checkSeatFree não trava nada: o banco recusa duas reservas simultâneas
+	if err := s.memberRepo.Save(ctx, member); err != nil {
+		if errors.Is(err, room.ErrSeatTaken) {
+			return nil, ErrSeatReserved
+		}
+		return nil, err
+	}
+
+	s.live.ReserveSeat(r.ID, member.UserID, member.SeatID)
+
+	return member, nil
+}
+
+// checkSeatFree verifica se o assento não está reservado para outro membro.
+func (s *Service) checkSeatFree(ctx context.Context, roomID room.ID, seatID string, userID user.ID) error {
+	members, err := s.memberRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		if m.SeatID == seatID && m.UserID != userID {
+			return ErrSeatReserved
+		}
+	}
+	return nil
+}
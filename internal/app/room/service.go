@@ -3,7 +3,11 @@ package room
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/vinib1903/cineus-api/internal/app/events"
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	apptheme "github.com/vinib1903/cineus-api/internal/app/theme"
 	"github.com/vinib1903/cineus-api/internal/domain/room"
 	"github.com/vinib1903/cineus-api/internal/domain/user"
 	"github.com/vinib1903/cineus-api/internal/infra/auth"
@@ -15,6 +19,8 @@ var (
 	ErrRoomNotFound    = errors.New("room not found")
 	ErrNotRoomOwner    = errors.New("you are not the owner of this room")
 	ErrInvalidCode     = errors.New("invalid access code")
+	ErrRoomNotPrivate  = errors.New("room is not private")
+	ErrTooManyAttempts = errors.New("too many access code attempts")
 )
 
 // MaxRoomsPerUser é o limite de salas por usuário.
@@ -22,24 +28,68 @@ const MaxRoomsPerUser = 2
 
 // Service contém a lógica de negócio de salas.
 type Service struct {
-	roomRepo room.Repository
-	idGen    *auth.IDGenerator
+	roomRepo     room.Repository
+	banRepo      room.BanRepository
+	memberRepo   room.MemberRepository
+	transferRepo room.TransferRepository
+	eventRepo    room.EventRepository
+	inviteRepo   room.InviteRepository
+	auditRepo    room.AuditRepository
+	userRepo     user.Repository
+	idGen        *auth.IDGenerator
+	events       events.Publisher
+	notifier     *notification.Service
+	live         LiveRooms
+	joinGuard    *JoinGuard
+	retention    *Retention
+	themes       *apptheme.Service
 }
 
 // NewService cria uma nova instância do serviço.
-func NewService(roomRepo room.Repository, idGen *auth.IDGenerator) *Service {
+func NewService(
+	roomRepo room.Repository,
+	banRepo room.BanRepository,
+	memberRepo room.MemberRepository,
+	transferRepo room.TransferRepository,
+	eventRepo room.EventRepository,
+	inviteRepo room.InviteRepository,
+	auditRepo room.AuditRepository,
+	userRepo user.Repository,
+	idGen *auth.IDGenerator,
+	publisher events.Publisher,
+	notifier *notification.Service,
+	live LiveRooms,
+	joinGuard *JoinGuard,
+	retention *Retention,
+	themes *apptheme.Service,
+) *Service {
 	return &Service{
-		roomRepo: roomRepo,
-		idGen:    idGen,
+		roomRepo:     roomRepo,
+		banRepo:      banRepo,
+		memberRepo:   memberRepo,
+		transferRepo: transferRepo,
+		eventRepo:    eventRepo,
+		inviteRepo:   inviteRepo,
+		auditRepo:    auditRepo,
+		userRepo:     userRepo,
+		idGen:        idGen,
+		events:       publisher,
+		notifier:     notifier,
+		live:         live,
+		joinGuard:    joinGuard,
+		retention:    retention,
+		themes:       themes,
 	}
 }
 
 // CreateInput são os dados para criar uma sala.
+// Se Theme estiver vazio, usa o tema padrão das preferências do dono.
 type CreateInput struct {
-	OwnerID    user.ID
-	Name       string
-	Theme      room.Theme
-	Visibility room.Visibility
+	OwnerID        user.ID
+	Name           string
+	Theme          room.Theme
+	Visibility     room.Visibility
+	Classification room.Classification // Opcional (tags, categoria, idioma e faixa etária)
 }
 
 // CreateOutput é o resultado da criação.
@@ -58,6 +108,16 @@ func (s *Service) Create(ctx context.Context, input CreateInput) (*CreateOutput,
 		return nil, ErrMaxRoomsReached
 	}
 
+	// Usar o tema padrão do dono se nenhum foi escolhido
+	if input.Theme == "" {
+		input.Theme, err = s.defaultTheme(ctx, input.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := s.themes.CheckUnlocked(ctx, input.OwnerID, input.Theme); err != nil {
+		return nil, err
+	}
+
 	// Gerar ID
 	roomID := room.ID(s.idGen.NewID())
 
@@ -66,32 +126,40 @@ func (s *Service) Create(ctx context.Context, input CreateInput) (*CreateOutput,
 	if err != nil {
 		return nil, err
 	}
+	if err := newRoom.Classify(input.OwnerID, input.Classification); err != nil {
+		return nil, err
+	}
 
 	// Salvar no banco
 	if err := s.roomRepo.Create(ctx, newRoom); err != nil {
 		return nil, err
 	}
 
-	return &CreateOutput{Room: newRoom}, nil
-}
+	// Publicar evento (conquistas)
+	event := events.New(events.TypeRoomCreated, newRoom.OwnerID, newRoom.ID)
+	event.Theme = newRoom.Theme
+	s.events.Publish(event)
 
-// ListPublicInput são os dados para listar salas públicas.
-type ListPublicInput struct {
-	Limit  int
-	Offset int
+	return &CreateOutput{Room: newRoom}, nil
 }
 
-// ListPublic retorna as salas públicas.
-func (s *Service) ListPublic(ctx context.Context, input ListPublicInput) ([]*room.Room, error) {
-	// Valores padrão
-	if input.Limit <= 0 {
-		input.Limit = 20
-	}
-	if input.Limit > 100 {
-		input.Limit = 100
+// defaultTheme retorna o tema padrão das preferências do usuário.
+// Se o tema não existir mais ou ainda estiver bloqueado, usa o padrão do catálogo.
+func (s *Service) defaultTheme(ctx context.Context, userID user.ID) (room.Theme, error) {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
 	}
 
-	return s.roomRepo.ListPublic(ctx, input.Limit, input.Offset)
+	theme := room.Theme(u.Settings.DefaultRoomTheme)
+	err = s.themes.CheckUnlocked(ctx, userID, theme)
+	switch {
+	case errors.Is(err, room.ErrInvalidTheme), errors.Is(err, room.ErrThemeLocked):
+		return room.ThemeDefault, nil
+	case err != nil:
+		return "", err
+	}
+	return theme, nil
 }
 
 // ListByOwner retorna as salas de um usuário.
@@ -111,24 +179,175 @@ func (s *Service) GetByID(ctx context.Context, id room.ID) (*room.Room, error) {
 	return r, nil
 }
 
+// UpdateInput são os dados para alterar uma sala.
+// Campos nil mantêm o valor atual.
+type UpdateInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	Name        *string
+	Theme       *room.Theme
+
+	Tags          *[]string
+	Category      *room.Category
+	Language      *string
+	ContentRating *room.ContentRating
+}
+
+// hasClassification verifica se algum campo da classificação foi informado.
+func (input UpdateInput) hasClassification() bool {
+	return input.Tags != nil || input.Category != nil || input.Language != nil || input.ContentRating != nil
+}
+
+// Update altera o nome, o tema e/ou a classificação de uma sala.
+// As mudanças são propagadas para quem está conectado na sala.
+func (s *Service) Update(ctx context.Context, input UpdateInput) (*room.Room, error) {
+	r, err := s.getOwnedRoom(ctx, input.RoomID, input.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		if err := r.UpdateName(input.RequesterID, *input.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Theme != nil {
+		if err := r.UpdateTheme(input.RequesterID, *input.Theme); err != nil {
+			return nil, err
+		}
+		if err := s.themes.CheckUnlocked(ctx, input.RequesterID, *input.Theme); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.hasClassification() {
+		c := r.Classification()
+		if input.Tags != nil {
+			c.Tags = *input.Tags
+		}
+		if input.Category != nil {
+			c.Category = *input.Category
+		}
+		if input.Language != nil {
+			c.Language = *input.Language
+		}
+		if input.ContentRating != nil {
+			c.ContentRating = *input.ContentRating
+		}
+		if err := r.Classify(input.RequesterID, c); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.roomRepo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+
+	// Avisar quem está na sala
+	s.live.UpdateRoomInfo(r.ID, r.Name, r.Theme)
+
+	// Registrar os campos alterados com os novos valores
+	entry := room.NewAuditEntry(r.ID, room.AuditSettingsUpdate, input.RequesterID, "", "")
+	if input.Name != nil {
+		entry.Details["name"] = r.Name
+	}
+	if input.Theme != nil {
+		entry.Details["theme"] = string(r.Theme)
+	}
+	if input.Tags != nil {
+		entry.Details["tags"] = strings.Join(r.Tags, ",")
+	}
+	if input.Category != nil {
+		entry.Details["category"] = string(r.Category)
+	}
+	if input.Language != nil {
+		entry.Details["language"] = r.Language
+	}
+	if input.ContentRating != nil {
+		entry.Details["content_rating"] = string(r.ContentRating)
+	}
+	s.recordAudit(ctx, entry)
+
+	return r, nil
+}
+
+// RegenerateAccessCode gera um novo código de acesso para uma sala privada.
+// O código antigo deixa de funcionar.
+func (s *Service) RegenerateAccessCode(ctx context.Context, roomID room.ID, requesterID user.ID) (*room.Room, error) {
+	r, err := s.getOwnedRoom(ctx, roomID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsPrivate() {
+		return nil, ErrRoomNotPrivate
+	}
+
+	if err := r.RegenerateAccessCode(requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.roomRepo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+
+	s.recordAudit(ctx, room.NewAuditEntry(r.ID, room.AuditAccessCodeRegenerate, requesterID, "", ""))
+
+	return r, nil
+}
+
+// getOwnedRoom busca uma sala e verifica se o usuário é o dono.
+func (s *Service) getOwnedRoom(ctx context.Context, roomID room.ID, requesterID user.ID) (*room.Room, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsOwner(requesterID) {
+		return nil, ErrNotRoomOwner
+	}
+
+	return r, nil
+}
+
 // JoinByCodeInput são os dados para entrar em uma sala por código.
 type JoinByCodeInput struct {
 	AccessCode string
 	UserID     user.ID
+	ClientIP   string
 }
 
 // JoinByCode busca uma sala pelo código de acesso.
+// Quem entra pelo código vira membro e não precisa mais dele.
+// As tentativas são limitadas por usuário e por IP.
 func (s *Service) JoinByCode(ctx context.Context, input JoinByCodeInput) (*room.Room, error) {
-	r, err := s.roomRepo.GetByAccessCode(ctx, input.AccessCode)
+	if !s.joinGuard.Allow(input.UserID, input.ClientIP) {
+		return nil, ErrTooManyAttempts
+	}
+
+	r, err := s.roomRepo.GetByAccessCode(ctx, room.NormalizeAccessCode(input.AccessCode))
 	if err != nil {
 		if errors.Is(err, room.ErrRoomNotFound) {
+			s.joinGuard.RecordFailure(input.UserID, input.ClientIP)
 			return nil, ErrInvalidCode
 		}
 		return nil, err
 	}
 
-	// TODO: Verificar se usuário está banido
-	// TODO: Verificar se sala está cheia
+	// Verificar se usuário está banido
+	if err := s.checkNotBanned(ctx, r.ID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// Verificar se sala está cheia (assentos + espectadores)
+	if !s.live.CanAdmit(r.ID, input.UserID) {
+		return nil, room.ErrRoomFull
+	}
+
+	if err := s.grantMembership(ctx, r, input.UserID); err != nil {
+		return nil, err
+	}
 
 	return r, nil
 }
@@ -137,9 +356,11 @@ func (s *Service) JoinByCode(ctx context.Context, input JoinByCodeInput) (*room.
 type DeleteInput struct {
 	RoomID      room.ID
 	RequesterID user.ID
+	Force       bool // Deleta mesmo com gente conectada (desconecta todos)
 }
 
 // Delete deleta uma sala.
+// A sala precisa estar vazia, a menos que Force seja usado.
 func (s *Service) Delete(ctx context.Context, input DeleteInput) error {
 	// Buscar a sala
 	r, err := s.roomRepo.GetByID(ctx, input.RoomID)
@@ -155,15 +376,21 @@ func (s *Service) Delete(ctx context.Context, input DeleteInput) error {
 		return ErrNotRoomOwner
 	}
 
-	// TODO: Verificar se a sala está vazia
-	// Por enquanto, assumimos que está vazia
-	isEmpty := true
+	// Verificar se a sala está vazia (conexões WebSocket ativas)
+	isEmpty := s.live.ClientCount(r.ID) == 0
 
 	// Deletar (soft delete)
-	if err := r.Delete(input.RequesterID, isEmpty); err != nil {
+	if err := r.Delete(input.RequesterID, isEmpty || input.Force); err != nil {
 		return err
 	}
 
 	// Atualizar no banco
-	return s.roomRepo.Update(ctx, r)
+	if err := s.roomRepo.Update(ctx, r); err != nil {
+		return err
+	}
+
+	// Tirar quem estiver conectado (inclusive quem entrou durante a deleção)
+	s.live.CloseRoom(r.ID, "Room was deleted by the owner")
+
+	return nil
 }
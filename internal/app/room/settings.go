@@ -0,0 +1,100 @@
+package room
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// UpdateSettingsInput são as configurações a alterar.
+// Campos nil mantêm o valor atual.
+type UpdateSettingsInput struct {
+	RoomID          room.ID
+	RequesterID     user.ID
+	SlowModeSeconds *int
+	ChatEnabled     *bool
+	LinksAllowed    *bool
+	MediaControl    *room.MediaControl
+	GuestsAllowed   *bool
+}
+
+// GetSettings retorna as configurações de uma sala.
+func (s *Service) GetSettings(ctx context.Context, roomID room.ID) (*room.Settings, error) {
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Settings, nil
+}
+
+// UpdateSettings altera parcialmente as configurações da sala.
+// Apenas o dono pode alterar (pela API ou pelo WebSocket);
+// quem está conectado recebe a mudança na hora.
+func (s *Service) UpdateSettings(ctx context.Context, input UpdateSettingsInput) (*room.Settings, error) {
+	r, err := s.getOwnedRoom(ctx, input.RoomID, input.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := r.Settings
+	if input.SlowModeSeconds != nil {
+		settings.SlowModeSeconds = *input.SlowModeSeconds
+	}
+	if input.ChatEnabled != nil {
+		settings.ChatEnabled = *input.ChatEnabled
+	}
+	if input.LinksAllowed != nil {
+		settings.LinksAllowed = *input.LinksAllowed
+	}
+	if input.MediaControl != nil {
+		settings.MediaControl = *input.MediaControl
+	}
+	if input.GuestsAllowed != nil {
+		settings.GuestsAllowed = *input.GuestsAllowed
+	}
+
+	if err := s.saveSettings(ctx, r, input.RequesterID, settings); err != nil {
+		return nil, err
+	}
+
+	return &r.Settings, nil
+}
+
+// saveSettings grava as novas configurações, aplica na sala ativa
+// e registra os campos alterados no log de auditoria.
+func (s *Service) saveSettings(ctx context.Context, r *room.Room, requesterID user.ID, settings room.Settings) error {
+	previous := r.Settings
+
+	if err := r.UpdateSettings(requesterID, settings); err != nil {
+		return err
+	}
+
+	if err := s.roomRepo.Update(ctx, r); err != nil {
+		return err
+	}
+
+	s.live.UpdateRoomSettings(r.ID, r.Settings)
+
+	entry := room.NewAuditEntry(r.ID, room.AuditSettingsUpdate, requesterID, "", "")
+	if previous.SlowModeSeconds != r.Settings.SlowModeSeconds {
+		entry.Details["slow_mode_seconds"] = strconv.Itoa(r.Settings.SlowModeSeconds)
+	}
+	if previous.ChatEnabled != r.Settings.ChatEnabled {
+		entry.Details["chat_enabled"] = strconv.FormatBool(r.Settings.ChatEnabled)
+	}
+	if previous.LinksAllowed != r.Settings.LinksAllowed {
+		entry.Details["links_allowed"] = strconv.FormatBool(r.Settings.LinksAllowed)
+	}
+	if previous.MediaControl != r.Settings.MediaControl {
+		entry.Details["media_control"] = string(r.Settings.MediaControl)
+	}
+	if previous.GuestsAllowed != r.Settings.GuestsAllowed {
+		entry.Details["guests_allowed"] = strconv.FormatBool(r.Settings.GuestsAllowed)
+	}
+	s.recordAudit(ctx, entry)
+
+	return nil
+}
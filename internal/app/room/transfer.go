@@ -0,0 +1,178 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// OfferTransferInput são os dados para oferecer a sala a outro usuário.
+type OfferTransferInput struct {
+	RoomID      room.ID
+	RequesterID user.ID
+	ToUserID    user.ID
+}
+
+// OfferTransfer oferece a posse da sala a outro usuário. Apenas o dono pode fazer isso.
+// Uma nova oferta substitui a anterior; a posse só muda quando o destinatário aceitar.
+func (s *Service) OfferTransfer(ctx context.Context, input OfferTransferInput) (*room.Transfer, error) {
+	r, err := s.getOwnedRoom(ctx, input.RoomID, input.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := room.NewTransfer(r, input.RequesterID, input.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o destinatário existe
+	if _, err := s.userRepo.GetByID(ctx, input.ToUserID); err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	// Um usuário banido não pode receber a sala
+	if err := s.checkNotBanned(ctx, r.ID, input.ToUserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.transferRepo.Save(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	err = s.notifier.Notify(ctx, transfer.ToUserID, notification.Notification{
+		Type:  notification.TypeRoomTransferOffer,
+		Title: "Room transfer",
+		Body:  "You were offered ownership of " + r.Name,
+		Data: map[string]interface{}{
+			"room_id":      string(r.ID),
+			"from_user_id": string(transfer.FromUserID),
+			"expires_at":   transfer.ExpiresAt,
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to notify transfer offer for room %s: %v", r.ID, err)
+	}
+
+	return transfer, nil
+}
+
+// GetTransfer retorna a transferência pendente de uma sala.
+// Visível apenas para o dono e para o destinatário.
+func (s *Service) GetTransfer(ctx context.Context, roomID room.ID, requesterID user.ID) (*room.Transfer, error) {
+	transfer, err := s.transferRepo.GetByRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requesterID != transfer.FromUserID && requesterID != transfer.ToUserID {
+		return nil, room.ErrTransferNotFound
+	}
+
+	if transfer.IsExpired() {
+		return nil, room.ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+// ListIncomingTransfers lista as salas oferecidas ao usuário.
+func (s *Service) ListIncomingTransfers(ctx context.Context, userID user.ID) ([]*room.Transfer, error) {
+	return s.transferRepo.ListByRecipient(ctx, userID)
+}
+
+// AcceptTransfer aceita a posse da sala oferecida ao usuário.
+// Respeita o limite de salas do novo dono; o antigo dono continua como membro.
+func (s *Service) AcceptTransfer(ctx context.Context, roomID room.ID, userID user.ID) (*room.Room, error) {
+	transfer, err := s.pendingTransferFor(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// O destinatário pode ter sido banido depois da oferta
+	if err := s.checkNotBanned(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := r.TransferOwnership(userID); err != nil {
+		return nil, err
+	}
+
+	// O antigo dono continua na sala como membro
+	previousOwner, err := room.NewMember(r.ID, transfer.FromUserID, room.RoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dono, oferta e membro mudam juntos; a transação também confere
+	// o dono atual e o limite de salas do destinatário
+	if err := s.transferRepo.Accept(ctx, transfer, previousOwner, MaxRoomsPerUser); err != nil {
+		switch {
+		case errors.Is(err, room.ErrRoomLimitReached):
+			return nil, ErrMaxRoomsReached
+		case errors.Is(err, room.ErrRoomNotFound):
+			return nil, ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	s.live.TransferOwnership(r.ID, userID)
+
+	return r, nil
+}
+
+// DeclineTransfer recusa a sala oferecida ao usuário.
+func (s *Service) DeclineTransfer(ctx context.Context, roomID room.ID, userID user.ID) error {
+	if _, err := s.pendingTransferFor(ctx, roomID, userID); err != nil {
+		return err
+	}
+
+	return s.transferRepo.Delete(ctx, roomID)
+}
+
+// CancelTransfer cancela a oferta pendente. Apenas o dono pode fazer isso.
+func (s *Service) CancelTransfer(ctx context.Context, roomID room.ID, requesterID user.ID) error {
+	if _, err := s.getOwnedRoom(ctx, roomID, requesterID); err != nil {
+		return err
+	}
+
+	if _, err := s.transferRepo.GetByRoom(ctx, roomID); err != nil {
+		return err
+	}
+
+	return s.transferRepo.Delete(ctx, roomID)
+}
+
+// pendingTransferFor busca a oferta da sala destinada ao usuário.
+// Ofertas expiradas são removidas.
+func (s *Service) pendingTransferFor(ctx context.Context, roomID room.ID, userID user.ID) (*room.Transfer, error) {
+	transfer, err := s.transferRepo.GetByRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.ToUserID != userID {
+		return nil, room.ErrTransferNotFound
+	}
+
+	if transfer.IsExpired() {
+		if err := s.transferRepo.Delete(ctx, roomID); err != nil {
+			return nil, err
+		}
+		return nil, room.ErrTransferExpired
+	}
+
+	return transfer, nil
+}
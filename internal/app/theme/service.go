@@ -0,0 +1,212 @@
+package theme
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/achievement"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros do serviço de temas.
+var (
+	ErrUnknownAchievement = errors.New("unlock achievement does not exist")
+)
+
+// Service gerencia o catálogo de temas.
+// O catálogo fica em memória (room.SetThemes) e é recarregado a cada mudança.
+type Service struct {
+	themeRepo       room.ThemeRepository
+	achievementRepo achievement.Repository
+}
+
+// NewService cria uma nova instância do serviço.
+func NewService(themeRepo room.ThemeRepository, achievementRepo achievement.Repository) *Service {
+	return &Service{
+		themeRepo:       themeRepo,
+		achievementRepo: achievementRepo,
+	}
+}
+
+// Load carrega o catálogo do banco para a memória.
+func (s *Service) Load(ctx context.Context) error {
+	themes, err := s.themeRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	defs := make([]room.ThemeDefinition, len(themes))
+	for i, t := range themes {
+		defs[i] = *t
+	}
+	room.SetThemes(defs)
+
+	return nil
+}
+
+// RunRefresh recarrega o catálogo a cada interval (mudanças feitas por outras instâncias).
+func (s *Service) RunRefresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Themes: failed to refresh catalog: %v", err)
+			}
+		}
+	}
+}
+
+// List retorna o catálogo de temas.
+func (s *Service) List() []room.ThemeDefinition {
+	return room.Themes()
+}
+
+// Get busca um tema do catálogo.
+func (s *Service) Get(id room.Theme) (*room.ThemeDefinition, error) {
+	def, ok := room.LookupTheme(id)
+	if !ok {
+		return nil, room.ErrThemeNotFound
+	}
+	return &def, nil
+}
+
+// CheckUnlocked verifica se o usuário pode usar o tema.
+// Temas com conquista exigida só podem ser usados por quem já a liberou.
+func (s *Service) CheckUnlocked(ctx context.Context, userID user.ID, id room.Theme) error {
+	def, ok := room.LookupTheme(id)
+	if !ok {
+		return room.ErrInvalidTheme
+	}
+	if def.UnlockAchievement == "" {
+		return nil
+	}
+
+	unlocks, err := s.achievementRepo.ListUnlocked(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range unlocks {
+		if string(u.AchievementID) == def.UnlockAchievement {
+			return nil
+		}
+	}
+	return room.ErrThemeLocked
+}
+
+// ThemeInput são os dados de um tema.
+// Na atualização, campos nil mantêm o valor atual.
+type ThemeInput struct {
+	DisplayName       *string
+	Assets            map[string]string
+	Palette           map[string]string
+	SeatColumns       *int
+	SeatAisles        []int
+	UnlockAchievement *string // Vazio remove o requisito
+	SortOrder         *int
+}
+
+// Create adiciona um tema ao catálogo.
+func (s *Service) Create(ctx context.Context, id room.Theme, input ThemeInput) (*room.ThemeDefinition, error) {
+	now := time.Now()
+	def := &room.ThemeDefinition{
+		ID:          id,
+		SeatColumns: 8,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	applyInput(def, input)
+
+	if err := s.validate(def); err != nil {
+		return nil, err
+	}
+
+	if err := s.themeRepo.Create(ctx, def); err != nil {
+		return nil, err
+	}
+
+	return def, s.Load(ctx)
+}
+
+// Update altera um tema do catálogo.
+// Salas abertas continuam com o layout antigo até serem reabertas.
+func (s *Service) Update(ctx context.Context, id room.Theme, input ThemeInput) (*room.ThemeDefinition, error) {
+	def, err := s.themeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applyInput(def, input)
+	def.UpdatedAt = time.Now()
+
+	if err := s.validate(def); err != nil {
+		return nil, err
+	}
+
+	if err := s.themeRepo.Update(ctx, def); err != nil {
+		return nil, err
+	}
+
+	return def, s.Load(ctx)
+}
+
+// Delete remove um tema que nenhuma sala usa.
+func (s *Service) Delete(ctx context.Context, id room.Theme) error {
+	if id == room.ThemeDefault {
+		return room.ErrCannotDeleteDefault
+	}
+
+	if err := s.themeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Load(ctx)
+}
+
+// validate verifica o tema e a conquista exigida.
+func (s *Service) validate(def *room.ThemeDefinition) error {
+	if err := def.Validate(); err != nil {
+		return err
+	}
+
+	if def.UnlockAchievement != "" {
+		if _, err := achievement.Get(achievement.ID(def.UnlockAchievement)); err != nil {
+			return ErrUnknownAchievement
+		}
+	}
+
+	return nil
+}
+
+// applyInput copia os campos informados para o tema.
+func applyInput(def *room.ThemeDefinition, input ThemeInput) {
+	if input.DisplayName != nil {
+		def.DisplayName = *input.DisplayName
+	}
+	if input.Assets != nil {
+		def.Assets = input.Assets
+	}
+	if input.Palette != nil {
+		def.Palette = input.Palette
+	}
+	if input.SeatColumns != nil {
+		def.SeatColumns = *input.SeatColumns
+	}
+	if input.SeatAisles != nil {
+		def.SeatAisles = input.SeatAisles
+	}
+	if input.UnlockAchievement != nil {
+		def.UnlockAchievement = *input.UnlockAchievement
+	}
+	if input.SortOrder != nil {
+		def.SortOrder = *input.SortOrder
+	}
+}
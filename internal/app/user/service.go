@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Erros do serviço de usuário.
+var (
+	ErrUserNotFound   = errors.New("user not found")
+	ErrSearchTooShort = errors.New("search query too short (min 2 characters)")
+	ErrSearchTooLong  = errors.New("search query too long (max 50 characters)")
+	ErrInvalidTheme   = errors.New("invalid default room theme")
+)
+
+// Limites da busca de usuários.
+const (
+	MinSearchLength    = 2
+	MaxSearchLength    = user.MaxDisplayNameLength
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 50
+)
+
+// Service contém a lógica de negócio de usuários.
+type Service struct {
+	userRepo user.Repository
+}
+
+// NewService cria uma nova instância do serviço.
+func NewService(userRepo user.Repository) *Service {
+	return &Service{
+		userRepo: userRepo,
+	}
+}
+
+// GetByID busca um usuário pelo ID.
+func (s *Service) GetByID(ctx context.Context, id user.ID) (*user.User, error) {
+	u, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+// SearchInput são os dados para buscar usuários.
+type SearchInput struct {
+	Query       string
+	RequesterID user.ID
+	Limit       int
+	Offset      int
+}
+
+// Search busca usuários pelo nome de exibição.
+// O próprio usuário que busca não aparece nos resultados.
+func (s *Service) Search(ctx context.Context, input SearchInput) ([]*user.User, error) {
+	query := strings.TrimSpace(input.Query)
+
+	if len(query) < MinSearchLength {
+		return nil, ErrSearchTooShort
+	}
+	if len(query) > MaxSearchLength {
+		return nil, ErrSearchTooLong
+	}
+
+	// Valores padrão
+	if input.Limit <= 0 {
+		input.Limit = DefaultSearchLimit
+	}
+	if input.Limit > MaxSearchLimit {
+		input.Limit = MaxSearchLimit
+	}
+	if input.Offset < 0 {
+		input.Offset = 0
+	}
+
+	return s.userRepo.Search(ctx, query, input.RequesterID, input.Limit, input.Offset)
+}
+
+// GetSettings retorna as preferências do usuário.
+func (s *Service) GetSettings(ctx context.Context, userID user.ID) (*user.Settings, error) {
+	u, err := s.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &u.Settings, nil
+}
+
+// UpdateSettingsInput são as preferências a alterar.
+// Campos nil mantêm o valor atual.
+type UpdateSettingsInput struct {
+	UserID                 user.ID
+	Locale                 *string
+	DefaultRoomTheme       *string
+	ChatSound              *bool
+	Autoplay               *bool
+	AllowDMsFromNonFriends *bool
+	Discoverable           *bool
+	NotifyAchievements     *bool
+	HideAdultRooms         *bool
+}
+
+// UpdateSettings altera parcialmente as preferências do usuário.
+func (s *Service) UpdateSettings(ctx context.Context, input UpdateSettingsInput) (*user.Settings, error) {
+	u, err := s.GetByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := u.Settings
+	if input.Locale != nil {
+		settings.Locale = *input.Locale
+	}
+	if input.DefaultRoomTheme != nil {
+		settings.DefaultRoomTheme = *input.DefaultRoomTheme
+	}
+	if input.ChatSound != nil {
+		settings.ChatSound = *input.ChatSound
+	}
+	if input.Autoplay != nil {
+		settings.Autoplay = *input.Autoplay
+	}
+	if input.AllowDMsFromNonFriends != nil {
+		settings.AllowDMsFromNonFriends = *input.AllowDMsFromNonFriends
+	}
+	if input.Discoverable != nil {
+		settings.Discoverable = *input.Discoverable
+	}
+	if input.NotifyAchievements != nil {
+		settings.NotifyAchievements = *input.NotifyAchievements
+	}
+	if input.HideAdultRooms != nil {
+		settings.HideAdultRooms = *input.HideAdultRooms
+	}
+
+	// Validar tema padrão
+	if !room.IsValidTheme(room.Theme(settings.DefaultRoomTheme)) {
+		return nil, ErrInvalidTheme
+	}
+
+	if err := u.UpdateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.Update(ctx, u); err != nil {
+		return nil, err
+	}
+
+	return &u.Settings, nil
+}
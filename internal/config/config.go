@@ -44,7 +44,24 @@ type JWTConfig struct {
 // RoomConfig contém configurações das salas.
 type RoomConfig struct {
 	IdleTimeoutSeconds int
-	MaxSeats           int
+	MaxSeats           int // Limite de assentos por sala (o layout vem do tema)
+	SpectatorSlots     int // Vagas extras além dos assentos (só assistem e conversam)
+	SeatHoldSeconds    int // Tempo que o assento de quem caiu fica guardado
+
+	// Formato dos códigos de acesso de salas privadas
+	AccessCodeLength   int
+	AccessCodeAlphabet string
+
+	// Limites de tentativas de entrada por código
+	JoinAttemptsPerUser int
+	JoinAttemptsPerIP   int
+	JoinAttemptsWindow  time.Duration
+	JoinFailureAlert    int // Falhas na janela que disparam alerta
+
+	// Salas deletadas: prazo para restaurar e limpeza definitiva
+	RestoreWindow time.Duration
+	PurgeInterval time.Duration
+	PurgeBatch    int
 }
 
 // Load carrega as configurações do arquivo .env e variáveis de ambiente.
@@ -76,6 +93,20 @@ func Load() *Config {
 		Room: RoomConfig{
 			IdleTimeoutSeconds: getIntEnv("ROOM_IDLE_TIMEOUT_SECONDS", 120),
 			MaxSeats:           getIntEnv("ROOM_MAX_SEATS", 16),
+			SpectatorSlots:     getIntEnv("ROOM_SPECTATOR_SLOTS", 8),
+			SeatHoldSeconds:    getIntEnv("ROOM_SEAT_HOLD_SECONDS", 60),
+
+			AccessCodeLength:   getIntEnv("ROOM_ACCESS_CODE_LENGTH", 8),
+			AccessCodeAlphabet: getEnv("ROOM_ACCESS_CODE_ALPHABET", "23456789ABCDEFGHJKMNPQRSTUVWXYZ"),
+
+			JoinAttemptsPerUser: getIntEnv("ROOM_JOIN_ATTEMPTS_PER_USER", 10),
+			JoinAttemptsPerIP:   getIntEnv("ROOM_JOIN_ATTEMPTS_PER_IP", 30),
+			JoinAttemptsWindow:  getDurationEnv("ROOM_JOIN_ATTEMPTS_WINDOW", 10*time.Minute),
+			JoinFailureAlert:    getIntEnv("ROOM_JOIN_FAILURE_ALERT", 8),
+
+			RestoreWindow: getDurationEnv("ROOM_RESTORE_WINDOW", 30*24*time.Hour),
+			PurgeInterval: getDurationEnv("ROOM_PURGE_INTERVAL", time.Hour),
+			PurgeBatch:    getIntEnv("ROOM_PURGE_BATCH", 100),
 		},
 	}
 }
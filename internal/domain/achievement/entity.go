@@ -0,0 +1,120 @@
+package achievement
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// ID é o identificador de uma conquista do catálogo.
+type ID string
+
+func (id ID) String() string {
+	return string(id)
+}
+
+// Metric é um contador de progresso do usuário usado para liberar conquistas.
+type Metric string
+
+const (
+	MetricPartiesHosted      Metric = "parties_hosted"       // Sessões hospedadas com convidados
+	MetricWatchSeconds       Metric = "watch_seconds"        // Tempo assistindo em salas
+	MetricHorrorRoomsCreated Metric = "horror_rooms_created" // Salas de terror criadas
+	MetricChatMessages       Metric = "chat_messages"        // Mensagens enviadas em salas
+)
+
+// Achievement é uma conquista do catálogo.
+// É liberada quando a métrica do usuário atinge o Threshold.
+type Achievement struct {
+	ID          ID
+	Name        string
+	Description string
+	Metric      Metric
+	Threshold   int64
+	XPReward    int64
+}
+
+// Unlock representa uma conquista liberada por um usuário.
+type Unlock struct {
+	UserID        user.ID
+	AchievementID ID
+	UnlockedAt    time.Time
+}
+
+// Erros de conquistas.
+var (
+	ErrAchievementNotFound = errors.New("achievement not found")
+)
+
+// catalog é a lista de conquistas disponíveis.
+var catalog = []Achievement{
+	{
+		ID:          "first_party",
+		Name:        "Opening Night",
+		Description: "Host your first watch party",
+		Metric:      MetricPartiesHosted,
+		Threshold:   1,
+		XPReward:    50,
+	},
+	{
+		ID:          "host_10_parties",
+		Name:        "Party Host",
+		Description: "Host 10 watch parties",
+		Metric:      MetricPartiesHosted,
+		Threshold:   10,
+		XPReward:    200,
+	},
+	{
+		ID:          "watch_24_hours",
+		Name:        "Movie Marathon",
+		Description: "Watch 24 hours in rooms",
+		Metric:      MetricWatchSeconds,
+		Threshold:   24 * 60 * 60,
+		XPReward:    300,
+	},
+	{
+		ID:          "first_horror_room",
+		Name:        "Fright Night",
+		Description: "Create your first horror-theme room",
+		Metric:      MetricHorrorRoomsCreated,
+		Threshold:   1,
+		XPReward:    50,
+	},
+	{
+		ID:          "chat_100_messages",
+		Name:        "Chatterbox",
+		Description: "Send 100 chat messages in rooms",
+		Metric:      MetricChatMessages,
+		Threshold:   100,
+		XPReward:    100,
+	},
+}
+
+// Catalog retorna todas as conquistas disponíveis.
+func Catalog() []Achievement {
+	result := make([]Achievement, len(catalog))
+	copy(result, catalog)
+	return result
+}
+
+// Get busca uma conquista do catálogo pelo ID.
+func Get(id ID) (Achievement, error) {
+	for _, a := range catalog {
+		if a.ID == id {
+			return a, nil
+		}
+	}
+	return Achievement{}, ErrAchievementNotFound
+}
+
+// Reached retorna as conquistas da métrica cujo limite já foi atingido por current.
+func Reached(metric Metric, current int64) []Achievement {
+	var reached []Achievement
+	for _, a := range catalog {
+		if a.Metric == metric && current >= a.Threshold {
+			reached = append(reached, a)
+		}
+	}
+	return reached
+}
@@ -0,0 +1,23 @@
+package achievement
+
+import (
+	"context"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Repository define as operações de persistência de progresso e conquistas.
+type Repository interface {
+	// IncrementProgress soma amount à métrica do usuário.
+	// Retorna o novo valor da métrica.
+	IncrementProgress(ctx context.Context, userID user.ID, metric Metric, amount int64) (int64, error)
+
+	// Unlock registra uma conquista liberada e soma xpReward ao XP do usuário
+	// na mesma transação. Retorna false se o usuário já tinha a conquista
+	// (nenhum XP é concedido) e o XP total do usuário depois da liberação.
+	Unlock(ctx context.Context, unlock *Unlock, xpReward int64) (bool, int64, error)
+
+	// ListUnlocked retorna as conquistas liberadas por um usuário.
+	// Ordenadas por data de liberação (mais recentes primeiro).
+	ListUnlocked(ctx context.Context, userID user.ID) ([]*Unlock, error)
+}
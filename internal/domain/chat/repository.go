@@ -14,6 +14,12 @@ var (
 	ErrMessageNotFound = errors.New("message not found")
 )
 
+// Cursor identifica a mensagem mais antiga de uma página (ordem por data de criação).
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
 // MessageRepository define as operações de persistência para mensagens de sala.
 type MessageRepository interface {
 	// Create salva uma nova mensagem.
@@ -21,9 +27,9 @@ type MessageRepository interface {
 
 	// ListByRoom retorna mensagens de uma sala.
 	// Ordenadas por data (mais recentes primeiro).
-	// before: retorna mensagens anteriores a este timestamp (para paginação).
+	// before: retorna mensagens anteriores a este cursor (para paginação).
 	// limit: quantidade máxima de mensagens.
-	ListByRoom(ctx context.Context, roomID room.ID, before *time.Time, limit int) ([]*Message, error)
+	ListByRoom(ctx context.Context, roomID room.ID, before *Cursor, limit int) ([]*Message, error)
 }
 
 // DirectMessageRepository define as operações para mensagens diretas.
@@ -32,8 +38,8 @@ type DirectMessageRepository interface {
 	Create(ctx context.Context, dm *DirectMessage) error
 
 	// ListConversation retorna mensagens entre dois usuários.
-	// Ordenadas por data (mais recentes primeiro).
-	ListConversation(ctx context.Context, userA, userB user.ID, before *time.Time, limit int) ([]*DirectMessage, error)
+	// Ordenadas por data (mais recentes primeiro), anteriores ao cursor before.
+	ListConversation(ctx context.Context, userA, userB user.ID, before *Cursor, limit int) ([]*DirectMessage, error)
 
 	// MarkAsRead marca mensagens como lidas.
 	// Marca todas as mensagens de fromUserID para toUserID como lidas.
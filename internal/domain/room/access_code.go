@@ -0,0 +1,85 @@
+package room
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// AccessCodeFormat define o formato dos códigos de acesso gerados.
+// Códigos antigos continuam válidos: a busca é pelo código normalizado.
+type AccessCodeFormat struct {
+	Length   int
+	Alphabet string
+}
+
+// Limites do formato do código de acesso.
+const (
+	MinAccessCodeLength   = 6
+	MaxAccessCodeLength   = 32
+	MinAccessCodeAlphabet = 16
+)
+
+// DefaultAccessCodeFormat gera 8 caracteres sem os ambíguos (0/O, 1/I/L).
+var DefaultAccessCodeFormat = AccessCodeFormat{
+	Length:   8,
+	Alphabet: "23456789ABCDEFGHJKMNPQRSTUVWXYZ",
+}
+
+// ErrInvalidAccessCodeFormat indica um formato de código inválido.
+var ErrInvalidAccessCodeFormat = errors.New("invalid access code format")
+
+// accessCodeFormat é o formato em uso. Configurado na inicialização.
+var accessCodeFormat = DefaultAccessCodeFormat
+
+// SetAccessCodeFormat altera o formato dos novos códigos de acesso.
+// Deve ser chamado na inicialização, antes de criar salas.
+func SetAccessCodeFormat(format AccessCodeFormat) error {
+	if format.Length < MinAccessCodeLength || format.Length > MaxAccessCodeLength {
+		return ErrInvalidAccessCodeFormat
+	}
+
+	seen := make(map[rune]bool)
+	for _, c := range format.Alphabet {
+		// Apenas letras maiúsculas e dígitos (o código é normalizado para maiúsculas)
+		if !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') {
+			return ErrInvalidAccessCodeFormat
+		}
+		if seen[c] {
+			return ErrInvalidAccessCodeFormat
+		}
+		seen[c] = true
+	}
+	if len(seen) < MinAccessCodeAlphabet {
+		return ErrInvalidAccessCodeFormat
+	}
+
+	accessCodeFormat = format
+	return nil
+}
+
+// NormalizeAccessCode deixa o código digitado no formato armazenado:
+// maiúsculo, sem espaços nem hífens.
+func NormalizeAccessCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
+
+// generateAccessCode gera um código aleatório no formato configurado.
+func generateAccessCode() (string, error) {
+	alphabet := accessCodeFormat.Alphabet
+	size := big.NewInt(int64(len(alphabet)))
+
+	code := make([]byte, accessCodeFormat.Length)
+	for i := range code {
+		// crypto/rand é seguro para criptografia e não tem viés
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		code[i] = alphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
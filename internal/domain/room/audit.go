@@ -0,0 +1,73 @@
+package room
+
+import (
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// AuditID é o identificador único de um registro de auditoria.
+type AuditID string
+
+func (id AuditID) String() string {
+	return string(id)
+}
+
+// AuditAction é o tipo de ação registrada no log de auditoria.
+type AuditAction string
+
+const (
+	AuditBan                  AuditAction = "ban"
+	AuditUnban                AuditAction = "unban"
+	AuditKick                 AuditAction = "kick"
+	AuditMute                 AuditAction = "mute"
+	AuditUnmute               AuditAction = "unmute"
+	AuditRoleChange           AuditAction = "role_change"
+	AuditAccessCodeRegenerate AuditAction = "access_code_regenerate"
+	AuditSettingsUpdate       AuditAction = "settings_update"
+)
+
+// AuditEntry é um registro do log de auditoria de uma sala.
+// Registros nunca são alterados depois de gravados.
+type AuditEntry struct {
+	ID        AuditID
+	RoomID    ID
+	Action    AuditAction
+	ActorID   user.ID
+	TargetID  user.ID           // Vazio quando a ação não tem alvo (ex: edição da sala)
+	Reason    string            // Opcional
+	Details   map[string]string // Dados da ação (ex: novo papel, campos alterados)
+	CreatedAt time.Time
+}
+
+// MaxAuditReasonLength é o tamanho máximo do motivo registrado.
+const MaxAuditReasonLength = 200
+
+// NewAuditEntry cria um registro de auditoria.
+func NewAuditEntry(roomID ID, action AuditAction, actorID, targetID user.ID, reason string) *AuditEntry {
+	// Corta por caracteres (e não bytes) para não gravar UTF-8 inválido
+	if runes := []rune(reason); len(runes) > MaxAuditReasonLength {
+		reason = string(runes[:MaxAuditReasonLength])
+	}
+
+	return &AuditEntry{
+		RoomID:    roomID,
+		Action:    action,
+		ActorID:   actorID,
+		TargetID:  targetID,
+		Reason:    reason,
+		Details:   map[string]string{},
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsValidAuditAction verifica se a ação existe.
+func IsValidAuditAction(action AuditAction) bool {
+	switch action {
+	case AuditBan, AuditUnban, AuditKick, AuditMute, AuditUnmute,
+		AuditRoleChange, AuditAccessCodeRegenerate, AuditSettingsUpdate:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,35 @@
+package room
+
+import (
+	"context"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// AuditFilter são os filtros da consulta ao log de auditoria.
+// Campos vazios não filtram.
+type AuditFilter struct {
+	RoomID   ID // Vazio = todas as salas (visão da plataforma)
+	Action   AuditAction
+	ActorID  user.ID
+	TargetID user.ID
+	Before   *AuditCursor // Registros anteriores a este (próxima página)
+	Limit    int
+}
+
+// AuditCursor aponta para o último registro de uma página.
+type AuditCursor struct {
+	CreatedAt time.Time
+	ID        AuditID
+}
+
+// AuditRepository define as operações de persistência do log de auditoria.
+// O log só aceita inclusões.
+type AuditRepository interface {
+	// Append grava um novo registro.
+	Append(ctx context.Context, entry *AuditEntry) error
+
+	// List retorna os registros que atendem ao filtro, dos mais recentes para os mais antigos.
+	List(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error)
+}
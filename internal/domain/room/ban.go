@@ -45,9 +45,9 @@ func NewBan(id BanID, roomID ID, userID, bannedBy user.ID, reason string, expire
 		return nil, ErrCannotBanSelf
 	}
 
-	// Limitar tamanho do motivo
-	if len(reason) > MaxBanReasonLength {
-		reason = reason[:MaxBanReasonLength]
+	// Limitar tamanho do motivo (por caracteres, para não gravar UTF-8 inválido)
+	if runes := []rune(reason); len(runes) > MaxBanReasonLength {
+		reason = string(runes[:MaxBanReasonLength])
 	}
 
 	return &Ban{
@@ -0,0 +1,187 @@
+package room
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Category é o assunto principal da sala.
+type Category string
+
+const (
+	CategoryMovies      Category = "movies"
+	CategorySeries      Category = "series"
+	CategoryAnime       Category = "anime"
+	CategoryDocumentary Category = "documentary"
+	CategoryMusic       Category = "music"
+	CategorySports      Category = "sports"
+	CategoryGaming      Category = "gaming"
+	CategoryOther       Category = "other"
+)
+
+// ContentRating é a classificação indicativa da sala.
+type ContentRating string
+
+const (
+	RatingAll ContentRating = "all"
+	Rating12  ContentRating = "12+"
+	Rating16  ContentRating = "16+"
+	Rating18  ContentRating = "18+" // Conteúdo adulto
+)
+
+// Classification reúne os dados que descrevem o conteúdo da sala.
+type Classification struct {
+	Tags          []string
+	Category      Category // Vazio = sem categoria
+	Language      string   // Código do idioma (ex: "pt" ou "pt-BR"), vazio = não informado
+	ContentRating ContentRating
+}
+
+// Erros da classificação.
+var (
+	ErrInvalidCategory      = errors.New("invalid category")
+	ErrInvalidLanguage      = errors.New("invalid language")
+	ErrInvalidContentRating = errors.New("invalid content rating")
+	ErrInvalidTag           = errors.New("invalid tag")
+	ErrTooManyTags          = errors.New("too many tags")
+)
+
+// MaxTags é o número máximo de tags por sala.
+const MaxTags = 5
+
+var (
+	tagPattern      = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,19}$`)
+	languagePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+)
+
+// IsValidCategory verifica se a categoria existe.
+func IsValidCategory(category Category) bool {
+	switch category {
+	case CategoryMovies, CategorySeries, CategoryAnime, CategoryDocumentary,
+		CategoryMusic, CategorySports, CategoryGaming, CategoryOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidContentRating verifica se a classificação indicativa existe.
+func IsValidContentRating(rating ContentRating) bool {
+	switch rating {
+	case RatingAll, Rating12, Rating16, Rating18:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeTags padroniza as tags (minúsculas, sem "#" e sem repetidas).
+// Sempre retorna uma lista, mesmo vazia.
+func NormalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if !tagPattern.MatchString(tag) {
+			return nil, ErrInvalidTag
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+
+	if len(result) > MaxTags {
+		return nil, ErrTooManyTags
+	}
+	return result, nil
+}
+
+// NormalizeLanguage padroniza o código do idioma ("PT-br" vira "pt-BR").
+func NormalizeLanguage(language string) (string, error) {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return "", nil
+	}
+
+	primary, region, hasRegion := strings.Cut(language, "-")
+	language = strings.ToLower(primary)
+	if hasRegion {
+		language += "-" + strings.ToUpper(region)
+	}
+
+	if !languagePattern.MatchString(language) {
+		return "", ErrInvalidLanguage
+	}
+	return language, nil
+}
+
+// Validate verifica e padroniza a classificação.
+// Classificação indicativa vazia vira "all".
+func (c *Classification) Validate() error {
+	tags, err := NormalizeTags(c.Tags)
+	if err != nil {
+		return err
+	}
+	c.Tags = tags
+
+	if c.Category != "" && !IsValidCategory(c.Category) {
+		return ErrInvalidCategory
+	}
+
+	if c.Language, err = NormalizeLanguage(c.Language); err != nil {
+		return err
+	}
+
+	if c.ContentRating == "" {
+		c.ContentRating = RatingAll
+	}
+	if !IsValidContentRating(c.ContentRating) {
+		return ErrInvalidContentRating
+	}
+
+	return nil
+}
+
+// Classification retorna a classificação atual da sala.
+func (r *Room) Classification() Classification {
+	return Classification{
+		Tags:          append([]string{}, r.Tags...),
+		Category:      r.Category,
+		Language:      r.Language,
+		ContentRating: r.ContentRating,
+	}
+}
+
+// Classify altera as tags, a categoria, o idioma e a classificação indicativa da sala.
+func (r *Room) Classify(requesterID user.ID, c Classification) error {
+	if r.IsDeleted() {
+		return ErrRoomDeleted
+	}
+
+	if !r.IsOwner(requesterID) {
+		return ErrNotOwner
+	}
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	r.Tags = c.Tags
+	r.Category = c.Category
+	r.Language = c.Language
+	r.ContentRating = c.ContentRating
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsAgeRestricted verifica se a sala é só para maiores de idade.
+func (r *Room) IsAgeRestricted() bool {
+	return r.ContentRating == Rating18
+}
@@ -1,8 +1,6 @@
 package room
 
 import (
-	"crypto/rand"
-	"encoding/hex"
 	"errors"
 	"strings"
 	"time"
@@ -21,15 +19,17 @@ func (id ID) IsEmpty() bool {
 	return id == ""
 }
 
-// Visibility define se a sala é pública ou privada.
+// Visibility define quem pode entrar na sala.
 type Visibility string
 
 const (
-	VisibilityPublic  Visibility = "public"
-	VisibilityPrivate Visibility = "private"
+	VisibilityPublic   Visibility = "public"
+	VisibilityPrivate  Visibility = "private"  // Só com código ou convite
+	VisibilityApproval Visibility = "approval" // Listada, mas a entrada depende de aprovação
 )
 
 // Theme representa o tema visual da sala.
+// Os temas vêm do catálogo (ver theme.go); estes são os que já vêm instalados.
 type Theme string
 
 const (
@@ -49,9 +49,19 @@ type Room struct {
 	Visibility Visibility
 	AccessCode *string // Código para entrar (salas privadas)
 	MaxSeats   int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  *time.Time // Soft delete
+
+	// Classificação (ver classification.go)
+	Tags          []string
+	Category      Category
+	Language      string
+	ContentRating ContentRating
+
+	// Configurações aplicadas ao vivo (ver settings.go)
+	Settings Settings
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time // Soft delete
 }
 
 // Erros de domínio da sala.
@@ -65,14 +75,16 @@ var (
 	ErrRoomNotEmpty       = errors.New("room is not empty")
 	ErrInvalidAccessCode  = errors.New("invalid access code")
 	ErrAccessCodeRequired = errors.New("access code is required for private rooms")
+	ErrRoomFull           = errors.New("room is full")
+	ErrRoomNotDeleted     = errors.New("room is not deleted")
+	ErrRestoreExpired     = errors.New("room can no longer be restored")
 )
 
 // Constantes de validação.
 const (
-	MinNameLength    = 3
-	MaxNameLength    = 25
-	AccessCodeLength = 4
-	DefaultMaxSeats  = 16
+	MinNameLength   = 3
+	MaxNameLength   = 25
+	DefaultMaxSeats = 16
 )
 
 // NewRoom cria uma nova sala com validações.
@@ -83,7 +95,7 @@ func NewRoom(id ID, ownerID user.ID, name string, theme Theme, visibility Visibi
 	}
 
 	// Validar tema
-	if !isValidTheme(theme) {
+	if !IsValidTheme(theme) {
 		return nil, ErrInvalidTheme
 	}
 
@@ -95,15 +107,18 @@ func NewRoom(id ID, ownerID user.ID, name string, theme Theme, visibility Visibi
 	now := time.Now()
 
 	room := &Room{
-		ID:         id,
-		OwnerID:    ownerID,
-		Name:       strings.TrimSpace(name),
-		Theme:      theme,
-		Visibility: visibility,
-		MaxSeats:   DefaultMaxSeats,
-		CreatedAt:  now,
-		UpdatedAt:  now,
-		DeletedAt:  nil,
+		ID:            id,
+		OwnerID:       ownerID,
+		Name:          strings.TrimSpace(name),
+		Theme:         theme,
+		Visibility:    visibility,
+		MaxSeats:      DefaultMaxSeats,
+		Tags:          []string{},
+		ContentRating: RatingAll,
+		Settings:      DefaultSettings(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DeletedAt:     nil,
 	}
 
 	// Gerar código de acesso para salas privadas
@@ -133,41 +148,16 @@ func validateName(name string) error {
 	return nil
 }
 
-// isValidTheme verifica se o tema é válido.
-func isValidTheme(theme Theme) bool {
-	switch theme {
-	case ThemeFarm, ThemeHorror, ThemeFun, ThemeSpace, ThemeDefault:
-		return true
-	default:
-		return false
-	}
-}
-
 // isValidVisibility verifica se a visibilidade é válida.
 func isValidVisibility(visibility Visibility) bool {
 	switch visibility {
-	case VisibilityPublic, VisibilityPrivate:
+	case VisibilityPublic, VisibilityPrivate, VisibilityApproval:
 		return true
 	default:
 		return false
 	}
 }
 
-// generateAccessCode gera um código aleatório de 4 caracteres.
-func generateAccessCode() (string, error) {
-	// Gerar 2 bytes aleatórios (= 4 caracteres em hex)
-	bytes := make([]byte, 2)
-
-	// crypto/rand é seguro para criptografia
-	_, err := rand.Read(bytes)
-	if err != nil {
-		return "", err
-	}
-
-	// Converter para hexadecimal e deixar maiúsculo
-	return strings.ToUpper(hex.EncodeToString(bytes)), nil
-}
-
 // IsDeleted verifica se a sala foi deletada.
 func (r *Room) IsDeleted() bool {
 	return r.DeletedAt != nil
@@ -183,6 +173,11 @@ func (r *Room) IsPrivate() bool {
 	return r.Visibility == VisibilityPrivate
 }
 
+// RequiresApproval verifica se a entrada na sala depende de aprovação.
+func (r *Room) RequiresApproval() bool {
+	return r.Visibility == VisibilityApproval
+}
+
 // IsOwner verifica se o usuário é o dono da sala.
 func (r *Room) IsOwner(userID user.ID) bool {
 	return r.OwnerID == userID
@@ -199,7 +194,7 @@ func (r *Room) ValidateAccess(code string) bool {
 		return false
 	}
 
-	return strings.EqualFold(*r.AccessCode, code)
+	return *r.AccessCode == NormalizeAccessCode(code)
 }
 
 // Delete marca a sala como deletada (soft delete).
@@ -223,6 +218,26 @@ func (r *Room) Delete(requesterID user.ID, isEmpty bool) error {
 	return nil
 }
 
+// Restore desfaz a deleção da sala, se ainda estiver dentro do prazo.
+// Apenas o dono pode restaurar.
+func (r *Room) Restore(requesterID user.ID, window time.Duration) error {
+	if !r.IsDeleted() {
+		return ErrRoomNotDeleted
+	}
+
+	if !r.IsOwner(requesterID) {
+		return ErrNotOwner
+	}
+
+	if time.Since(*r.DeletedAt) > window {
+		return ErrRestoreExpired
+	}
+
+	r.DeletedAt = nil
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
 // UpdateName atualiza o nome da sala.
 func (r *Room) UpdateName(requesterID user.ID, name string) error {
 	if r.IsDeleted() {
@@ -252,7 +267,7 @@ func (r *Room) UpdateTheme(requesterID user.ID, theme Theme) error {
 		return ErrNotOwner
 	}
 
-	if !isValidTheme(theme) {
+	if !IsValidTheme(theme) {
 		return ErrInvalidTheme
 	}
 
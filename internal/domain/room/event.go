@@ -0,0 +1,187 @@
+package room
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// EventID é o identificador único de uma sessão agendada.
+type EventID string
+
+func (id EventID) String() string {
+	return string(id)
+}
+
+// Event é uma sessão agendada em uma sala (ex: noite de filme).
+type Event struct {
+	ID             EventID
+	RoomID         ID
+	CreatedBy      user.ID
+	Title          string
+	Description    string
+	StartsAt       time.Time
+	MediaURL       string // Vídeo planejado (opcional)
+	MediaTitle     string
+	ReminderSentAt *time.Time
+	StartedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RSVPStatus é a resposta de um usuário ao convite da sessão.
+type RSVPStatus string
+
+const (
+	RSVPGoing    RSVPStatus = "going"
+	RSVPMaybe    RSVPStatus = "maybe"
+	RSVPDeclined RSVPStatus = "declined"
+)
+
+// RSVP é a resposta de um usuário a uma sessão agendada.
+type RSVP struct {
+	EventID   EventID
+	UserID    user.ID
+	Status    RSVPStatus
+	UpdatedAt time.Time
+}
+
+// Erros de sessões agendadas.
+var (
+	ErrEventNotFound          = errors.New("event not found")
+	ErrEventTitleTooShort     = errors.New("event title too short (min 3 characters)")
+	ErrEventTitleTooLong      = errors.New("event title too long (max 100 characters)")
+	ErrEventDescriptionLength = errors.New("event description too long (max 1000 characters)")
+	ErrEventInPast            = errors.New("event must start in the future")
+	ErrEventStarted           = errors.New("event has already started")
+	ErrInvalidMediaURL        = errors.New("invalid media URL")
+	ErrInvalidRSVPStatus      = errors.New("invalid RSVP status")
+	ErrRSVPNotFound           = errors.New("RSVP not found")
+)
+
+// Constantes de validação.
+const (
+	MinEventTitleLength     = 3
+	MaxEventTitleLength     = 100
+	MaxEventDescriptionSize = 1000
+	MaxMediaTitleLength     = 200
+)
+
+// NewEvent cria uma nova sessão agendada com validações.
+func NewEvent(id EventID, roomID ID, createdBy user.ID, title, description string, startsAt time.Time, mediaURL, mediaTitle string) (*Event, error) {
+	now := time.Now()
+
+	e := &Event{
+		ID:        id,
+		RoomID:    roomID,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := e.SetDetails(title, description); err != nil {
+		return nil, err
+	}
+	if err := e.Reschedule(startsAt); err != nil {
+		return nil, err
+	}
+	if err := e.SetMedia(mediaURL, mediaTitle); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// SetDetails altera título e descrição.
+func (e *Event) SetDetails(title, description string) error {
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+
+	length := utf8.RuneCountInString(title)
+	if length < MinEventTitleLength {
+		return ErrEventTitleTooShort
+	}
+	if length > MaxEventTitleLength {
+		return ErrEventTitleTooLong
+	}
+	if utf8.RuneCountInString(description) > MaxEventDescriptionSize {
+		return ErrEventDescriptionLength
+	}
+
+	e.Title = title
+	e.Description = description
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Reschedule altera o horário de início. O lembrete volta a ser enviado.
+func (e *Event) Reschedule(startsAt time.Time) error {
+	if e.HasStarted() {
+		return ErrEventStarted
+	}
+	if !startsAt.After(time.Now()) {
+		return ErrEventInPast
+	}
+
+	e.StartsAt = startsAt.UTC()
+	e.ReminderSentAt = nil
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetMedia altera o vídeo planejado. URL vazia remove o vídeo.
+func (e *Event) SetMedia(mediaURL, mediaTitle string) error {
+	mediaURL = strings.TrimSpace(mediaURL)
+	mediaTitle = strings.TrimSpace(mediaTitle)
+
+	if mediaURL != "" {
+		parsed, err := url.Parse(mediaURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return ErrInvalidMediaURL
+		}
+	} else {
+		mediaTitle = ""
+	}
+
+	if utf8.RuneCountInString(mediaTitle) > MaxMediaTitleLength {
+		mediaTitle = string([]rune(mediaTitle)[:MaxMediaTitleLength])
+	}
+
+	e.MediaURL = mediaURL
+	e.MediaTitle = mediaTitle
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasStarted verifica se a sessão já começou.
+func (e *Event) HasStarted() bool {
+	return e.StartedAt != nil
+}
+
+// NewRSVP cria a resposta de um usuário a uma sessão.
+func NewRSVP(eventID EventID, userID user.ID, status RSVPStatus) (*RSVP, error) {
+	if !status.IsValid() {
+		return nil, ErrInvalidRSVPStatus
+	}
+
+	return &RSVP{
+		EventID:   eventID,
+		UserID:    userID,
+		Status:    status,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// IsValid verifica se o status de RSVP existe.
+func (s RSVPStatus) IsValid() bool {
+	switch s {
+	case RSVPGoing, RSVPMaybe, RSVPDeclined:
+		return true
+	default:
+		return false
+	}
+}
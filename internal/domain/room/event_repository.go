@@ -0,0 +1,56 @@
+package room
+
+import (
+	"context"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// EventRepository define as operações de persistência para Event e RSVP.
+type EventRepository interface {
+	// Create salva uma nova sessão agendada.
+	Create(ctx context.Context, event *Event) error
+
+	// GetByID busca uma sessão pelo ID.
+	// Retorna ErrEventNotFound se não existir.
+	GetByID(ctx context.Context, id EventID) (*Event, error)
+
+	// Update atualiza os dados de uma sessão existente.
+	// Os marcadores do agendador (lembrete e início) não são gravados aqui;
+	// mudar o horário de início zera o lembrete.
+	Update(ctx context.Context, event *Event) error
+
+	// MarkReminderSent registra o envio do lembrete se ele ainda não foi enviado.
+	// Retorna false se outra execução já enviou (ou a sessão já começou).
+	MarkReminderSent(ctx context.Context, id EventID) (bool, error)
+
+	// MarkStarted registra o início da sessão se ela ainda não começou.
+	// Retorna false se outra execução já iniciou.
+	MarkStarted(ctx context.Context, id EventID) (bool, error)
+
+	// Delete remove uma sessão e suas respostas.
+	Delete(ctx context.Context, id EventID) error
+
+	// ListByRoom lista as sessões de uma sala que começam a partir de since.
+	// Ordenadas pelo horário de início.
+	ListByRoom(ctx context.Context, roomID ID, since time.Time) ([]*Event, error)
+
+	// ListDueReminders lista as sessões de salas ativas ainda sem lembrete
+	// que começam até before.
+	ListDueReminders(ctx context.Context, before time.Time) ([]*Event, error)
+
+	// ListDueStarts lista as sessões de salas ativas ainda não iniciadas
+	// cujo horário de início já chegou.
+	ListDueStarts(ctx context.Context, now time.Time) ([]*Event, error)
+
+	// SaveRSVP cria ou atualiza a resposta de um usuário.
+	SaveRSVP(ctx context.Context, rsvp *RSVP) error
+
+	// DeleteRSVP remove a resposta de um usuário.
+	// Retorna ErrRSVPNotFound se não existir.
+	DeleteRSVP(ctx context.Context, eventID EventID, userID user.ID) error
+
+	// ListRSVPs lista as respostas de uma sessão.
+	ListRSVPs(ctx context.Context, eventID EventID) ([]*RSVP, error)
+}
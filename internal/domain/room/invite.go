@@ -0,0 +1,117 @@
+package room
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// InviteID é o identificador único do convite.
+type InviteID string
+
+func (id InviteID) String() string {
+	return string(id)
+}
+
+// Invite é um link de convite para uma sala.
+// Aceitar o convite torna o usuário membro da sala.
+type Invite struct {
+	ID        InviteID
+	RoomID    ID
+	Token     string
+	CreatedBy user.ID
+	MaxUses   int        // 0 = ilimitado
+	Uses      int        // Quantas vezes já foi aceito
+	SeatID    string     // Assento reservado para quem aceitar (opcional)
+	ExpiresAt *time.Time // nil = não expira
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Erros de convites.
+var (
+	ErrInviteNotFound       = errors.New("invite not found")
+	ErrInviteExpired        = errors.New("invite has expired")
+	ErrInviteRevoked        = errors.New("invite has been revoked")
+	ErrInviteExhausted      = errors.New("invite has reached its maximum uses")
+	ErrInvalidInviteMaxUses = errors.New("invalid invite max uses")
+	ErrInvalidInviteExpiry  = errors.New("invite expiry must be in the future")
+	ErrInvalidSeat          = errors.New("invalid seat")
+)
+
+// Constantes de convites.
+const (
+	MaxInviteUses     = 1000
+	MaxInviteLifetime = 30 * 24 * time.Hour
+	maxSeatIDLength   = 10
+	inviteTokenBytes  = 18 // 24 caracteres em base64
+)
+
+// NewInvite cria um novo convite com um token aleatório.
+func NewInvite(id InviteID, roomID ID, createdBy user.ID, maxUses int, expiresAt *time.Time, seatID string) (*Invite, error) {
+	if maxUses < 0 || maxUses > MaxInviteUses {
+		return nil, ErrInvalidInviteMaxUses
+	}
+
+	now := time.Now()
+	if expiresAt != nil {
+		if !expiresAt.After(now) || expiresAt.Sub(now) > MaxInviteLifetime {
+			return nil, ErrInvalidInviteExpiry
+		}
+	}
+
+	seatID = NormalizeSeatID(seatID)
+	if len(seatID) > maxSeatIDLength {
+		return nil, ErrInvalidSeat
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Invite{
+		ID:        id,
+		RoomID:    roomID,
+		Token:     token,
+		CreatedBy: createdBy,
+		MaxUses:   maxUses,
+		SeatID:    seatID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// generateInviteToken gera um token aleatório seguro para URL.
+func generateInviteToken() (string, error) {
+	bytes := make([]byte, inviteTokenBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// Validate verifica se o convite ainda pode ser aceito.
+func (i *Invite) Validate() error {
+	if i.RevokedAt != nil {
+		return ErrInviteRevoked
+	}
+	if i.ExpiresAt != nil && time.Now().After(*i.ExpiresAt) {
+		return ErrInviteExpired
+	}
+	if i.MaxUses > 0 && i.Uses >= i.MaxUses {
+		return ErrInviteExhausted
+	}
+	return nil
+}
+
+// Revoke invalida o convite.
+func (i *Invite) Revoke() {
+	if i.RevokedAt == nil {
+		now := time.Now()
+		i.RevokedAt = &now
+	}
+}
@@ -0,0 +1,31 @@
+package room
+
+import "context"
+
+// InviteRepository define as operações de persistência para Invite.
+type InviteRepository interface {
+	// Create salva um novo convite.
+	Create(ctx context.Context, invite *Invite) error
+
+	// GetByID busca um convite pelo ID.
+	// Retorna ErrInviteNotFound se não existir.
+	GetByID(ctx context.Context, id InviteID) (*Invite, error)
+
+	// GetByToken busca um convite pelo token.
+	// Retorna ErrInviteNotFound se não existir.
+	GetByToken(ctx context.Context, token string) (*Invite, error)
+
+	// ListByRoom lista os convites de uma sala (mais recentes primeiro).
+	ListByRoom(ctx context.Context, roomID ID) ([]*Invite, error)
+
+	// Update atualiza um convite existente.
+	Update(ctx context.Context, invite *Invite) error
+
+	// Accept registra um uso do convite e grava o membro na mesma transação.
+	// Quem ainda não é membro entra com member.Role; quem já é mantém o papel
+	// atual e só recebe o assento. Se o assento tiver sido reservado para outro
+	// membro nesse meio tempo, o membro entra sem ele.
+	// Retorna se o assento foi reservado, ou ErrInviteExhausted se não houver
+	// mais usos, ou se o convite tiver sido revogado ou expirado nesse meio tempo.
+	Accept(ctx context.Context, id InviteID, member *Member) (bool, error)
+}
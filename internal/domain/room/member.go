@@ -0,0 +1,140 @@
+package room
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Role é o papel de um usuário dentro de uma sala.
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleCoHost    Role = "co_host"
+	RoleModerator Role = "moderator"
+	RoleMember    Role = "member"
+)
+
+// Permission é uma ação que depende do papel do usuário na sala.
+type Permission string
+
+const (
+	PermControlMedia Permission = "control_media" // Play, pause, seek, trocar vídeo
+	PermManageSeats  Permission = "manage_seats"  // Mover/liberar assentos de outros
+	PermKick         Permission = "kick"          // Expulsar e silenciar
+	PermBan          Permission = "ban"           // Banir e remover bans
+	PermEditSettings Permission = "edit_settings" // Nome, tema e configurações da sala
+	PermSchedule     Permission = "schedule"      // Agendar e editar sessões
+	PermInvite       Permission = "invite"        // Criar e revogar convites
+	PermAdmit        Permission = "admit"         // Aprovar entradas em salas com aprovação
+	PermViewAudit    Permission = "view_audit"    // Ler o log de auditoria da sala
+)
+
+// permissions é a matriz de permissões por papel.
+var permissions = map[Role][]Permission{
+	RoleOwner:     {PermControlMedia, PermManageSeats, PermKick, PermBan, PermEditSettings, PermSchedule, PermInvite, PermAdmit, PermViewAudit},
+	RoleCoHost:    {PermControlMedia, PermManageSeats, PermKick, PermBan, PermSchedule, PermInvite, PermAdmit, PermViewAudit},
+	RoleModerator: {PermManageSeats, PermKick, PermBan, PermInvite, PermAdmit, PermViewAudit},
+	RoleMember:    {},
+}
+
+// ranks define a hierarquia dos papéis (maior = mais poder).
+var ranks = map[Role]int{
+	RoleOwner:     3,
+	RoleCoHost:    2,
+	RoleModerator: 1,
+	RoleMember:    0,
+}
+
+// Member é o registro de um usuário em uma sala, com seu papel.
+// O dono não tem registro: seu papel vem de Room.OwnerID.
+type Member struct {
+	RoomID    ID
+	UserID    user.ID
+	Role      Role
+	SeatID    string // Assento reservado (opcional)
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Erros de membros.
+var (
+	ErrMemberNotFound    = errors.New("member not found")
+	ErrInvalidRole       = errors.New("invalid role")
+	ErrCannotAssignOwner = errors.New("owner role cannot be assigned")
+	ErrSeatTaken         = errors.New("seat is already reserved for another member")
+)
+
+// NewMember cria um novo registro de membro.
+func NewMember(roomID ID, userID user.ID, role Role) (*Member, error) {
+	if err := validateAssignableRole(role); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Member{
+		RoomID:    roomID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// ChangeRole altera o papel do membro.
+func (m *Member) ChangeRole(role Role) error {
+	if err := validateAssignableRole(role); err != nil {
+		return err
+	}
+
+	m.Role = role
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// validateAssignableRole verifica se o papel pode ser atribuído a um membro.
+func validateAssignableRole(role Role) error {
+	if role == RoleOwner {
+		return ErrCannotAssignOwner
+	}
+	if !role.IsValid() {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+// IsValid verifica se o papel existe.
+func (r Role) IsValid() bool {
+	_, exists := ranks[r]
+	return exists
+}
+
+// Can verifica se o papel tem a permissão.
+func (r Role) Can(p Permission) bool {
+	for _, allowed := range permissions[r] {
+		if allowed == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Outranks verifica se o papel está acima de outro na hierarquia.
+// Usado para impedir que um moderador expulse ou bana quem está acima dele.
+func (r Role) Outranks(other Role) bool {
+	return ranks[r] > ranks[other]
+}
+
+// RoleOf retorna o papel do usuário na sala, dado o seu registro de membro.
+// member pode ser nil (usuário sem registro = membro comum).
+func (r *Room) RoleOf(userID user.ID, member *Member) Role {
+	if r.IsOwner(userID) {
+		return RoleOwner
+	}
+	if member != nil && member.Role.IsValid() {
+		return member.Role
+	}
+	return RoleMember
+}
@@ -0,0 +1,22 @@
+package room
+
+import (
+	"context"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// MemberRepository define as operações de persistência para Member.
+type MemberRepository interface {
+	// Save cria ou atualiza o registro de um membro.
+	// Retorna ErrSeatTaken se o assento já estiver reservado para outro membro da sala.
+	Save(ctx context.Context, member *Member) error
+
+	// Get busca o registro de um usuário em uma sala.
+	// Retorna ErrMemberNotFound se não existir.
+	Get(ctx context.Context, roomID ID, userID user.ID) (*Member, error)
+
+	// ListByRoom lista os membros de uma sala.
+	// Ordenados por papel (mais alto primeiro) e data de entrada.
+	ListByRoom(ctx context.Context, roomID ID) ([]*Member, error)
+}
@@ -3,6 +3,7 @@ package room
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/vinib1903/cineus-api/internal/domain/user"
 )
@@ -12,6 +13,29 @@ var (
 	ErrRoomNotFound = errors.New("room not found")
 )
 
+// PageCursor identifica a última sala de uma página (ordem por data de criação).
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        ID
+}
+
+// PublicFilter são os filtros da listagem de salas públicas.
+// Campos vazios não filtram.
+type PublicFilter struct {
+	Theme      Theme
+	Query      string   // Busca por parte do nome
+	Tags       []string // A sala precisa ter todas estas tags
+	Category   Category
+	Language   string // "pt" também encontra "pt-BR"
+	Rating     ContentRating
+	HideAdult  bool        // Esconde as salas 18+
+	IDs        []ID        // Restringe a estas salas
+	ExcludeIDs []ID        // Ignora estas salas
+	After      *PageCursor // Salas criadas antes desta (próxima página)
+	Offset     int
+	Limit      int
+}
+
 // Repository define as operações de persistência para Room.
 type Repository interface {
 	// Create salva uma nova sala no banco.
@@ -27,14 +51,14 @@ type Repository interface {
 	// Não retorna salas deletadas.
 	GetByAccessCode(ctx context.Context, code string) (*Room, error)
 
-	// Update atualiza os dados de uma sala existente.
+	// Update atualiza os dados de uma sala existente (menos o dono).
 	// Retorna ErrRoomNotFound se não existir.
 	Update(ctx context.Context, room *Room) error
 
-	// ListPublic retorna todas as salas públicas não deletadas.
+	// ListPublic retorna as salas públicas e com aprovação, não deletadas, que atendem ao filtro.
 	// Ordenadas por data de criação (mais recentes primeiro).
-	// Suporta paginação com limit e offset.
-	ListPublic(ctx context.Context, limit, offset int) ([]*Room, error)
+	// Suporta paginação por cursor (After) ou por Offset.
+	ListPublic(ctx context.Context, filter PublicFilter) ([]*Room, error)
 
 	// ListByOwner retorna todas as salas de um usuário.
 	// Inclui públicas e privadas, mas não deletadas.
@@ -43,4 +67,37 @@ type Repository interface {
 	// CountByOwner conta quantas salas ativas um usuário possui.
 	// Usado para verificar o limite de 2 salas por usuário.
 	CountByOwner(ctx context.Context, ownerID user.ID) (int, error)
+
+	// GetDeletedByID busca uma sala deletada pelo ID.
+	// Retorna ErrRoomNotFound se não existir ou não estiver deletada.
+	GetDeletedByID(ctx context.Context, id ID) (*Room, error)
+
+	// ListDeletedByOwner retorna as salas de um usuário deletadas depois de since.
+	// Ordenadas pela deleção mais recente.
+	ListDeletedByOwner(ctx context.Context, ownerID user.ID, since time.Time) ([]*Room, error)
+
+	// PurgeDeleted apaga de vez até limit salas deletadas antes de before,
+	// junto com chat, bans, convites, membros e sessões.
+	// O log de auditoria é mantido (só inclusões, também após a limpeza).
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (PurgeResult, error)
+}
+
+// PurgeResult conta o que foi apagado em uma limpeza de salas deletadas.
+type PurgeResult struct {
+	Rooms        int64
+	ChatMessages int64
+	Bans         int64
+	Invites      int64
+	Members      int64
+	Events       int64
+}
+
+// Add soma outro resultado a este.
+func (p *PurgeResult) Add(other PurgeResult) {
+	p.Rooms += other.Rooms
+	p.ChatMessages += other.ChatMessages
+	p.Bans += other.Bans
+	p.Invites += other.Invites
+	p.Members += other.Members
+	p.Events += other.Events
 }
@@ -0,0 +1,145 @@
+package room
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SeatKind é o tipo de um assento no layout.
+type SeatKind string
+
+const (
+	SeatStandard   SeatKind = "standard"
+	SeatAccessible SeatKind = "accessible" // Acessível (pontas da primeira fila)
+	SeatVIP        SeatKind = "vip"        // Centro da fila do meio
+)
+
+// MaxSeatRows é o número máximo de filas (A até Z).
+const MaxSeatRows = 26
+
+// Seat é um assento do layout.
+type Seat struct {
+	ID       string // Fila + coluna, ex: "A1", "C12"
+	Row      string
+	Column   int // Começa em 1
+	Position int // Ordem do assento no layout (fila a fila)
+	Kind     SeatKind
+}
+
+// SeatLayout é a disposição dos assentos de uma sala.
+type SeatLayout struct {
+	Rows    int
+	Columns int
+	Aisles  []int // Corredor depois dessas colunas
+	Seats   []Seat
+}
+
+// seatTemplate define a forma das filas de um tema.
+type seatTemplate struct {
+	columns int
+	aisles  []int
+}
+
+// MaxSeatsFor retorna quantos assentos cabem no layout do tema.
+func MaxSeatsFor(theme Theme) int {
+	return templateFor(theme).columns * MaxSeatRows
+}
+
+// templateFor retorna a forma das filas do tema no catálogo (ou a do tema padrão).
+func templateFor(theme Theme) seatTemplate {
+	def, ok := LookupTheme(theme)
+	if !ok || def.SeatColumns < MinSeatColumns {
+		def, _ = LookupTheme(ThemeDefault)
+	}
+	return seatTemplate{columns: def.SeatColumns, aisles: def.SeatAisles}
+}
+
+// NewSeatLayout monta o layout do tema com a quantidade de assentos informada.
+// Os IDs e posições são sempre os mesmos para o mesmo tema e quantidade.
+// A última fila pode ficar incompleta.
+func NewSeatLayout(theme Theme, seatCount int) SeatLayout {
+	t := templateFor(theme)
+
+	if seatCount < 0 {
+		seatCount = 0
+	}
+	if limit := t.columns * MaxSeatRows; seatCount > limit {
+		seatCount = limit
+	}
+
+	rows := (seatCount + t.columns - 1) / t.columns
+	layout := SeatLayout{
+		Rows:    rows,
+		Columns: t.columns,
+		Aisles:  append([]int(nil), t.aisles...),
+		Seats:   make([]Seat, 0, seatCount),
+	}
+
+	for i := 0; i < seatCount; i++ {
+		row := i / t.columns
+		column := i%t.columns + 1
+
+		rowSize := t.columns
+		if row == rows-1 && seatCount%t.columns != 0 {
+			rowSize = seatCount % t.columns
+		}
+
+		layout.Seats = append(layout.Seats, Seat{
+			ID:       SeatID(row, column),
+			Row:      rowLabel(row),
+			Column:   column,
+			Position: i,
+			Kind:     seatKind(row, column, rows, rowSize),
+		})
+	}
+
+	return layout
+}
+
+// seatKind define o tipo do assento pela sua posição.
+func seatKind(row, column, rows, rowSize int) SeatKind {
+	// Pontas da primeira fila são acessíveis
+	if row == 0 && (column == 1 || column == rowSize) {
+		return SeatAccessible
+	}
+
+	// Centro da fila do meio é VIP (salas com pelo menos três filas)
+	if rows >= 3 && row == rows/2 {
+		center := (rowSize + 1) / 2
+		if column == center || (rowSize%2 == 0 && column == center+1) {
+			return SeatVIP
+		}
+	}
+
+	return SeatStandard
+}
+
+// SeatID monta o ID de um assento (fila começa em 0, coluna em 1).
+func SeatID(row, column int) string {
+	return rowLabel(row) + strconv.Itoa(column)
+}
+
+// rowLabel retorna a letra da fila.
+func rowLabel(row int) string {
+	return string(rune('A' + row))
+}
+
+// NormalizeSeatID padroniza um ID de assento informado pelo usuário.
+func NormalizeSeatID(seatID string) string {
+	return strings.ToUpper(strings.TrimSpace(seatID))
+}
+
+// Has verifica se o assento existe no layout.
+func (l SeatLayout) Has(seatID string) bool {
+	for _, seat := range l.Seats {
+		if seat.ID == seatID {
+			return true
+		}
+	}
+	return false
+}
+
+// SeatLayout retorna o layout de assentos da sala.
+func (r *Room) SeatLayout() SeatLayout {
+	return NewSeatLayout(r.Theme, r.MaxSeats)
+}
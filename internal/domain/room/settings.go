@@ -0,0 +1,110 @@
+package room
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// MediaControl define quem pode controlar o player da sala.
+type MediaControl string
+
+const (
+	MediaControlOwner    MediaControl = "owner"    // Só o dono
+	MediaControlHosts    MediaControl = "hosts"    // Dono e co-hosts
+	MediaControlEveryone MediaControl = "everyone" // Qualquer pessoa na sala
+)
+
+// Settings são as configurações da sala aplicadas ao vivo pelo RoomHub.
+// Ficam salvas junto com a sala como um documento.
+type Settings struct {
+	SlowModeSeconds int // Intervalo mínimo entre mensagens de chat (0 = desligado)
+	ChatEnabled     bool
+	LinksAllowed    bool // Links nas mensagens de chat
+	MediaControl    MediaControl
+	GuestsAllowed   bool // Quem não é membro da sala pode entrar
+}
+
+// Erros das configurações.
+var (
+	ErrInvalidSlowMode     = errors.New("invalid slow mode interval")
+	ErrInvalidMediaControl = errors.New("invalid media control")
+)
+
+// MaxSlowModeSeconds é o maior intervalo do modo lento.
+const MaxSlowModeSeconds = 300
+
+// DefaultSettings retorna as configurações de uma nova sala.
+func DefaultSettings() Settings {
+	return Settings{
+		SlowModeSeconds: 0,
+		ChatEnabled:     true,
+		LinksAllowed:    true,
+		MediaControl:    MediaControlHosts,
+		GuestsAllowed:   true,
+	}
+}
+
+// IsValidMediaControl verifica se a opção de controle de mídia existe.
+func IsValidMediaControl(mc MediaControl) bool {
+	switch mc {
+	case MediaControlOwner, MediaControlHosts, MediaControlEveryone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate verifica se as configurações são válidas.
+func (s Settings) Validate() error {
+	if s.SlowModeSeconds < 0 || s.SlowModeSeconds > MaxSlowModeSeconds {
+		return ErrInvalidSlowMode
+	}
+	if !IsValidMediaControl(s.MediaControl) {
+		return ErrInvalidMediaControl
+	}
+	return nil
+}
+
+// SlowMode retorna o intervalo do modo lento.
+func (s Settings) SlowMode() time.Duration {
+	return time.Duration(s.SlowModeSeconds) * time.Second
+}
+
+// CanControlMedia verifica se o papel pode controlar o player com estas configurações.
+func (s Settings) CanControlMedia(role Role) bool {
+	switch s.MediaControl {
+	case MediaControlOwner:
+		return role == RoleOwner
+	case MediaControlEveryone:
+		return true
+	default:
+		return role.Can(PermControlMedia)
+	}
+}
+
+// BypassesChatLimits verifica se o papel ignora os limites do chat
+// (chat desligado, modo lento e links). Vale para a equipe que modera a sala.
+func (s Settings) BypassesChatLimits(role Role) bool {
+	return role.Can(PermKick)
+}
+
+// UpdateSettings altera as configurações da sala.
+func (r *Room) UpdateSettings(requesterID user.ID, s Settings) error {
+	if r.IsDeleted() {
+		return ErrRoomDeleted
+	}
+
+	if !r.IsOwner(requesterID) {
+		return ErrNotOwner
+	}
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	r.Settings = s
+	r.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,192 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ThemeDefinition é um tema do catálogo: aparência, layout dos assentos e requisitos.
+type ThemeDefinition struct {
+	ID                Theme
+	DisplayName       string
+	Assets            map[string]string // Nome do asset -> URL (ex: "background")
+	Palette           map[string]string // Nome da cor -> hex (ex: "primary" -> "#1A1A2E")
+	SeatColumns       int               // Assentos por fila
+	SeatAisles        []int             // Corredor depois dessas colunas
+	UnlockAchievement string            // Conquista exigida para usar o tema (opcional)
+	SortOrder         int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Erros do catálogo de temas.
+var (
+	ErrThemeNotFound         = errors.New("theme not found")
+	ErrThemeExists           = errors.New("theme already exists")
+	ErrThemeInUse            = errors.New("theme is used by existing rooms")
+	ErrThemeLocked           = errors.New("theme is locked")
+	ErrCannotDeleteDefault   = errors.New("the default theme cannot be deleted")
+	ErrInvalidThemeID        = errors.New("invalid theme id")
+	ErrInvalidThemeName      = errors.New("invalid theme display name")
+	ErrInvalidThemeAssets    = errors.New("invalid theme assets")
+	ErrInvalidThemePalette   = errors.New("invalid theme palette")
+	ErrInvalidThemeSeatShape = errors.New("invalid theme seat layout")
+)
+
+// Limites dos temas.
+const (
+	MaxThemeNameLength = 50
+	MaxThemeEntries    = 20 // Assets ou cores por tema
+	MinSeatColumns     = 2
+	MaxSeatColumns     = 20
+)
+
+var (
+	themeIDPattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,31}$`)
+	themeKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+	hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// ThemeRepository define as operações de persistência do catálogo de temas.
+type ThemeRepository interface {
+	// List retorna todos os temas.
+	List(ctx context.Context) ([]*ThemeDefinition, error)
+
+	// GetByID busca um tema. Retorna ErrThemeNotFound se não existir.
+	GetByID(ctx context.Context, id Theme) (*ThemeDefinition, error)
+
+	// Create salva um novo tema. Retorna ErrThemeExists se o ID já existir.
+	Create(ctx context.Context, theme *ThemeDefinition) error
+
+	// Update atualiza um tema. Retorna ErrThemeNotFound se não existir.
+	Update(ctx context.Context, theme *ThemeDefinition) error
+
+	// Delete remove um tema. Retorna ErrThemeInUse se alguma sala o usa.
+	Delete(ctx context.Context, id Theme) error
+}
+
+// Validate verifica se o tema está completo e consistente.
+func (t *ThemeDefinition) Validate() error {
+	if !themeIDPattern.MatchString(string(t.ID)) {
+		return ErrInvalidThemeID
+	}
+
+	t.DisplayName = strings.TrimSpace(t.DisplayName)
+	if t.DisplayName == "" || len(t.DisplayName) > MaxThemeNameLength {
+		return ErrInvalidThemeName
+	}
+
+	if len(t.Assets) > MaxThemeEntries {
+		return ErrInvalidThemeAssets
+	}
+	for key, value := range t.Assets {
+		u, err := url.Parse(value)
+		if !themeKeyPattern.MatchString(key) || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidThemeAssets
+		}
+	}
+
+	if len(t.Palette) > MaxThemeEntries {
+		return ErrInvalidThemePalette
+	}
+	for key, value := range t.Palette {
+		if !themeKeyPattern.MatchString(key) || !hexColorPattern.MatchString(value) {
+			return ErrInvalidThemePalette
+		}
+	}
+
+	if t.SeatColumns < MinSeatColumns || t.SeatColumns > MaxSeatColumns {
+		return ErrInvalidThemeSeatShape
+	}
+	previous := 0
+	for _, aisle := range t.SeatAisles {
+		// Corredores em ordem crescente, sempre entre duas colunas
+		if aisle <= previous || aisle >= t.SeatColumns {
+			return ErrInvalidThemeSeatShape
+		}
+		previous = aisle
+	}
+
+	return nil
+}
+
+// builtinThemes são os temas instalados pela migração (sem assets e cores).
+// Valem até o catálogo ser carregado do banco.
+func builtinThemes() []ThemeDefinition {
+	return []ThemeDefinition{
+		{ID: ThemeDefault, DisplayName: "Cinema", SeatColumns: 8, SeatAisles: []int{4}, SortOrder: 0},
+		{ID: ThemeFarm, DisplayName: "Farm", SeatColumns: 6, SeatAisles: []int{3}, SortOrder: 1},
+		{ID: ThemeHorror, DisplayName: "Horror", SeatColumns: 8, SeatAisles: []int{2, 6}, SortOrder: 2},
+		{ID: ThemeFun, DisplayName: "Fun", SeatColumns: 10, SortOrder: 3},
+		{ID: ThemeSpace, DisplayName: "Space", SeatColumns: 6, SeatAisles: []int{2, 4}, SortOrder: 4},
+	}
+}
+
+// themeRegistry é o catálogo de temas em memória.
+var themeRegistry = struct {
+	mu     sync.RWMutex
+	themes map[Theme]ThemeDefinition
+}{
+	themes: indexThemes(builtinThemes()),
+}
+
+// indexThemes monta o mapa do catálogo.
+func indexThemes(defs []ThemeDefinition) map[Theme]ThemeDefinition {
+	themes := make(map[Theme]ThemeDefinition, len(defs))
+	for _, def := range defs {
+		themes[def.ID] = def
+	}
+	return themes
+}
+
+// SetThemes substitui o catálogo de temas em memória.
+// O tema padrão sempre existe: se faltar, o embutido é mantido.
+func SetThemes(defs []ThemeDefinition) {
+	themes := indexThemes(defs)
+	if _, ok := themes[ThemeDefault]; !ok {
+		themes[ThemeDefault] = builtinThemes()[0]
+	}
+
+	themeRegistry.mu.Lock()
+	defer themeRegistry.mu.Unlock()
+	themeRegistry.themes = themes
+}
+
+// LookupTheme busca um tema no catálogo.
+func LookupTheme(theme Theme) (ThemeDefinition, bool) {
+	themeRegistry.mu.RLock()
+	defer themeRegistry.mu.RUnlock()
+
+	def, ok := themeRegistry.themes[theme]
+	return def, ok
+}
+
+// Themes retorna o catálogo ordenado por SortOrder.
+func Themes() []ThemeDefinition {
+	themeRegistry.mu.RLock()
+	defs := make([]ThemeDefinition, 0, len(themeRegistry.themes))
+	for _, def := range themeRegistry.themes {
+		defs = append(defs, def)
+	}
+	themeRegistry.mu.RUnlock()
+
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].SortOrder != defs[j].SortOrder {
+			return defs[i].SortOrder < defs[j].SortOrder
+		}
+		return defs[i].ID < defs[j].ID
+	})
+	return defs
+}
+
+// IsValidTheme verifica se o tema existe no catálogo.
+func IsValidTheme(theme Theme) bool {
+	_, ok := LookupTheme(theme)
+	return ok
+}
@@ -0,0 +1,73 @@
+package room
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// Transfer é uma oferta de transferência de posse da sala.
+// Cada sala tem no máximo uma transferência pendente.
+type Transfer struct {
+	RoomID     ID
+	FromUserID user.ID
+	ToUserID   user.ID
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+// Erros de transferência.
+var (
+	ErrTransferNotFound     = errors.New("ownership transfer not found")
+	ErrTransferExpired      = errors.New("ownership transfer has expired")
+	ErrCannotTransferToSelf = errors.New("cannot transfer a room to yourself")
+	ErrRoomLimitReached     = errors.New("user has reached the maximum number of rooms")
+)
+
+// Constantes.
+const (
+	TransferTTL = 7 * 24 * time.Hour
+)
+
+// NewTransfer cria uma oferta de transferência da sala para outro usuário.
+// Apenas o dono pode oferecer a sala.
+func NewTransfer(r *Room, requesterID, toUserID user.ID) (*Transfer, error) {
+	if r.IsDeleted() {
+		return nil, ErrRoomDeleted
+	}
+
+	if !r.IsOwner(requesterID) {
+		return nil, ErrNotOwner
+	}
+
+	if toUserID == requesterID {
+		return nil, ErrCannotTransferToSelf
+	}
+
+	now := time.Now()
+
+	return &Transfer{
+		RoomID:     r.ID,
+		FromUserID: requesterID,
+		ToUserID:   toUserID,
+		ExpiresAt:  now.Add(TransferTTL),
+		CreatedAt:  now,
+	}, nil
+}
+
+// IsExpired verifica se a oferta expirou.
+func (t *Transfer) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// TransferOwnership passa a posse da sala para outro usuário.
+func (r *Room) TransferOwnership(newOwnerID user.ID) error {
+	if r.IsDeleted() {
+		return ErrRoomDeleted
+	}
+
+	r.OwnerID = newOwnerID
+	r.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,30 @@
+package room
+
+import (
+	"context"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// TransferRepository define as operações de persistência para Transfer.
+type TransferRepository interface {
+	// Save cria a transferência pendente da sala, substituindo a anterior.
+	Save(ctx context.Context, transfer *Transfer) error
+
+	// GetByRoom busca a transferência pendente de uma sala.
+	// Retorna ErrTransferNotFound se não existir.
+	GetByRoom(ctx context.Context, roomID ID) (*Transfer, error)
+
+	// ListByRecipient lista as transferências não expiradas oferecidas ao usuário.
+	ListByRecipient(ctx context.Context, userID user.ID) ([]*Transfer, error)
+
+	// Delete remove a transferência pendente de uma sala.
+	Delete(ctx context.Context, roomID ID) error
+
+	// Accept aplica a transferência em uma única transação: passa a sala para o
+	// destinatário, remove a oferta e mantém o antigo dono como previousOwner.
+	// Retorna ErrTransferNotFound se a oferta não existe mais ou a sala mudou de dono,
+	// ErrRoomNotFound se a sala foi deletada e ErrRoomLimitReached se o
+	// destinatário já tem maxRooms salas.
+	Accept(ctx context.Context, transfer *Transfer, previousOwner *Member, maxRooms int) error
+}
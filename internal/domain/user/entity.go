@@ -32,6 +32,8 @@ type User struct {
 	DisplayName   string
 	XP            int64
 	EmailVerified bool
+	IsAdmin       bool // Administrador da plataforma (definido direto no banco)
+	Settings      Settings
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	LastLoginAt   *time.Time // Ponteiro porque pode ser nulo (nunca logou)
@@ -82,6 +84,7 @@ func NewUser(id ID, email, passwordHash, displayName string) (*User, error) {
 		DisplayName:   strings.TrimSpace(displayName),
 		XP:            0,
 		EmailVerified: false,
+		Settings:      DefaultSettings(),
 		CreatedAt:     now,
 		UpdatedAt:     now,
 		LastLoginAt:   nil,
@@ -139,6 +142,17 @@ func (u *User) UpdateDisplayName(name string) error {
 	return nil
 }
 
+// UpdateSettings substitui as preferências do usuário.
+func (u *User) UpdateSettings(settings Settings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	u.Settings = settings
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
 // AddXP adiciona pontos de experiência ao usuário.
 func (u *User) AddXP(amount int64) {
 	if amount > 0 {
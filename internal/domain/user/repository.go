@@ -31,4 +31,10 @@ type Repository interface {
 
 	// ExistsByEmail verifica se já existe um usuário com este email.
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+
+	// Search busca usuários cujo nome começa com ou se parece com query.
+	// Resultados por prefixo vêm primeiro, seguidos dos mais similares.
+	// O usuário excludeID (normalmente quem está buscando) não é retornado,
+	// nem usuários com Settings.Discoverable desligado.
+	Search(ctx context.Context, query string, excludeID ID, limit, offset int) ([]*User, error)
 }
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"errors"
+)
+
+// Settings são as preferências do usuário.
+// Ficam salvas junto com o usuário como um documento.
+type Settings struct {
+	Locale                 string
+	DefaultRoomTheme       string // Tema usado ao criar salas sem tema
+	ChatSound              bool
+	Autoplay               bool
+	AllowDMsFromNonFriends bool
+	Discoverable           bool // Aparece na busca de usuários
+	NotifyAchievements     bool
+	HideAdultRooms         bool // Esconde as salas 18+ da listagem
+}
+
+// Erros de preferências.
+var (
+	ErrInvalidLocale = errors.New("invalid locale")
+)
+
+// SupportedLocales são os idiomas aceitos pela plataforma.
+var SupportedLocales = []string{"pt-BR", "en-US", "es-ES"}
+
+// DefaultSettings retorna as preferências padrão de um novo usuário.
+func DefaultSettings() Settings {
+	return Settings{
+		Locale:                 "pt-BR",
+		DefaultRoomTheme:       "default",
+		ChatSound:              true,
+		Autoplay:               true,
+		AllowDMsFromNonFriends: true,
+		Discoverable:           true,
+		NotifyAchievements:     true,
+		HideAdultRooms:         false,
+	}
+}
+
+// Validate verifica se as preferências são válidas.
+// O tema padrão é validado pelo serviço, que conhece os temas de sala.
+func (s Settings) Validate() error {
+	if !isSupportedLocale(s.Locale) {
+		return ErrInvalidLocale
+	}
+	return nil
+}
+
+// isSupportedLocale verifica se o idioma é suportado.
+func isSupportedLocale(locale string) bool {
+	for _, l := range SupportedLocales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
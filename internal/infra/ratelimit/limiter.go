@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// maxEntries é o tamanho a partir do qual entradas expiradas são limpas.
+const maxEntries = 10000
+
+// Limiter é um limitador de taxa em memória baseado em janela fixa.
+// Cada chave (usuário, IP, etc.) tem seu próprio contador.
+type Limiter struct {
+	limit  int
+	window time.Duration
+
+	// Contadores por chave
+	entries map[string]*entry
+
+	// Mutex para proteger o mapa
+	mu sync.Mutex
+}
+
+// entry é o contador de uma chave dentro da janela atual.
+type entry struct {
+	count   int
+	resetAt time.Time
+}
+
+// NewLimiter cria um limitador que permite até limit eventos por janela.
+func NewLimiter(limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  window,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Allow registra um evento para a chave e informa se ainda está dentro do limite.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	e := l.current(key, now)
+	if e.count >= l.limit {
+		return false
+	}
+
+	e.count++
+	return true
+}
+
+// Count registra um evento para a chave e retorna quantos houve na janela atual.
+// Não bloqueia: serve para contar falhas e disparar alertas.
+func (l *Limiter) Count(key string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e := l.current(key, time.Now())
+	e.count++
+	return e.count
+}
+
+// current retorna o contador da janela atual, criando um novo se necessário.
+// Deve ser chamado com o mutex travado.
+func (l *Limiter) current(key string, now time.Time) *entry {
+	e, exists := l.entries[key]
+	if exists && now.Before(e.resetAt) {
+		return e
+	}
+
+	if len(l.entries) >= maxEntries {
+		l.sweep(now)
+	}
+
+	e = &entry{resetAt: now.Add(l.window)}
+	l.entries[key] = e
+	return e
+}
+
+// sweep remove as entradas cuja janela já terminou.
+func (l *Limiter) sweep(now time.Time) {
+	for key, e := range l.entries {
+		if !now.Before(e.resetAt) {
+			delete(l.entries, key)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/achievement"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// AchievementRepository implementa achievement.Repository
+type AchievementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAchievementRepository cria uma nova instância do repositório.
+func NewAchievementRepository(pool *pgxpool.Pool) *AchievementRepository {
+	return &AchievementRepository{pool: pool}
+}
+
+// IncrementProgress soma amount à métrica do usuário.
+func (r *AchievementRepository) IncrementProgress(ctx context.Context, userID user.ID, metric achievement.Metric, amount int64) (int64, error) {
+	query := `
+		INSERT INTO user_stats (user_id, metric, value, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id, metric)
+		DO UPDATE SET value = user_stats.value + EXCLUDED.value,
+		              updated_at = NOW()
+		RETURNING value
+	`
+
+	var value int64
+	err := r.pool.QueryRow(ctx, query, userID, metric, amount).Scan(&value)
+	if err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
+
+// Unlock registra uma conquista liberada e concede o XP na mesma transação.
+// O XP é somado direto no banco para não sobrescrever outras alterações do usuário.
+func (r *AchievementRepository) Unlock(ctx context.Context, unlock *achievement.Unlock, xpReward int64) (bool, int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO user_achievements (user_id, achievement_id, unlocked_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, achievement_id) DO NOTHING
+	`, unlock.UserID, unlock.AchievementID, unlock.UnlockedAt)
+	if err != nil {
+		return false, 0, err
+	}
+	if result.RowsAffected() == 0 {
+		return false, 0, nil // Já liberada anteriormente
+	}
+
+	var totalXP int64
+	err = tx.QueryRow(ctx, `
+		UPDATE users
+		SET xp = xp + $2,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING xp
+	`, unlock.UserID, xpReward).Scan(&totalXP)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, 0, user.ErrUserNotFound
+		}
+		return false, 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, 0, err
+	}
+
+	return true, totalXP, nil
+}
+
+// ListUnlocked retorna as conquistas liberadas por um usuário.
+func (r *AchievementRepository) ListUnlocked(ctx context.Context, userID user.ID) ([]*achievement.Unlock, error) {
+	query := `
+		SELECT user_id, achievement_id, unlocked_at
+		FROM user_achievements
+		WHERE user_id = $1
+		ORDER BY unlocked_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var unlocks []*achievement.Unlock
+	for rows.Next() {
+		var u achievement.Unlock
+		if err := rows.Scan(&u.UserID, &u.AchievementID, &u.UnlockedAt); err != nil {
+			return nil, err
+		}
+		unlocks = append(unlocks, &u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return unlocks, nil
+}
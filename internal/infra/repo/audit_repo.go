@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// AuditRepository implementa room.AuditRepository
+type AuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditRepository cria uma nova instância do repositório.
+func NewAuditRepository(pool *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{pool: pool}
+}
+
+// Append grava um novo registro de auditoria.
+func (r *AuditRepository) Append(ctx context.Context, entry *room.AuditEntry) error {
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO room_audit_log (id, room_id, action, actor_id, target_id, reason, details, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, ''), $7, $8)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		entry.ID,
+		entry.RoomID,
+		entry.Action,
+		entry.ActorID,
+		entry.TargetID,
+		entry.Reason,
+		details,
+		entry.CreatedAt,
+	)
+	return err
+}
+
+// List retorna os registros que atendem ao filtro, dos mais recentes para os mais antigos.
+func (r *AuditRepository) List(ctx context.Context, filter room.AuditFilter) ([]*room.AuditEntry, error) {
+	var conditions []string
+	var args []interface{}
+
+	// arg adiciona um parâmetro e retorna o placeholder ($n)
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.RoomID != "" {
+		conditions = append(conditions, "room_id = "+arg(filter.RoomID))
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = "+arg(filter.Action))
+	}
+	if filter.ActorID != "" {
+		conditions = append(conditions, "actor_id = "+arg(filter.ActorID))
+	}
+	if filter.TargetID != "" {
+		conditions = append(conditions, "target_id = "+arg(filter.TargetID))
+	}
+	if filter.Before != nil {
+		conditions = append(conditions, "(created_at, id) < ("+arg(filter.Before.CreatedAt)+", "+arg(filter.Before.ID)+")")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+		SELECT id, room_id, action, actor_id, COALESCE(target_id::text, ''), COALESCE(reason, ''), details, created_at
+		FROM room_audit_log
+		` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + arg(filter.Limit)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*room.AuditEntry
+	for rows.Next() {
+		var entry room.AuditEntry
+		var details []byte
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.RoomID,
+			&entry.Action,
+			&entry.ActorID,
+			&entry.TargetID,
+			&entry.Reason,
+			&details,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(details, &entry.Details); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
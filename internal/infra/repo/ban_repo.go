@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// BanRepository implementa room.BanRepository
+type BanRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBanRepository cria uma nova instância do repositório.
+func NewBanRepository(pool *pgxpool.Pool) *BanRepository {
+	return &BanRepository{pool: pool}
+}
+
+// Create salva um novo banimento.
+// Se o usuário já tiver um ban (ativo ou expirado) na sala, ele é substituído.
+func (r *BanRepository) Create(ctx context.Context, ban *room.Ban) error {
+	query := `
+		INSERT INTO room_bans (id, room_id, user_id, banned_by, reason, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (room_id, user_id)
+		DO UPDATE SET banned_by = EXCLUDED.banned_by,
+		              reason = EXCLUDED.reason,
+		              expires_at = EXCLUDED.expires_at,
+		              created_at = EXCLUDED.created_at
+		RETURNING id
+	`
+
+	return r.pool.QueryRow(ctx, query,
+		ban.ID,
+		ban.RoomID,
+		ban.UserID,
+		ban.BannedBy,
+		ban.Reason,
+		ban.ExpiresAt,
+		ban.CreatedAt,
+	).Scan(&ban.ID)
+}
+
+// GetActiveBan busca um banimento ativo de um usuário em uma sala.
+func (r *BanRepository) GetActiveBan(ctx context.Context, roomID room.ID, userID user.ID) (*room.Ban, error) {
+	query := `
+		SELECT id, room_id, user_id, banned_by, COALESCE(reason, ''), expires_at, created_at
+		FROM room_bans
+		WHERE room_id = $1 AND user_id = $2
+		  AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var ban room.Ban
+	err := r.pool.QueryRow(ctx, query, roomID, userID).Scan(
+		&ban.ID,
+		&ban.RoomID,
+		&ban.UserID,
+		&ban.BannedBy,
+		&ban.Reason,
+		&ban.ExpiresAt,
+		&ban.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrBanNotFound
+		}
+		return nil, err
+	}
+
+	return &ban, nil
+}
+
+// IsUserBanned verifica se um usuário está banido de uma sala.
+func (r *BanRepository) IsUserBanned(ctx context.Context, roomID room.ID, userID user.ID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM room_bans
+			WHERE room_id = $1 AND user_id = $2
+			  AND (expires_at IS NULL OR expires_at > NOW())
+		)
+	`
+
+	var banned bool
+	err := r.pool.QueryRow(ctx, query, roomID, userID).Scan(&banned)
+	if err != nil {
+		return false, err
+	}
+
+	return banned, nil
+}
+
+// Delete remove um banimento (unban).
+func (r *BanRepository) Delete(ctx context.Context, id room.BanID) error {
+	query := `DELETE FROM room_bans WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrBanNotFound
+	}
+
+	return nil
+}
+
+// ListByRoom lista todos os bans ativos de uma sala.
+func (r *BanRepository) ListByRoom(ctx context.Context, roomID room.ID) ([]*room.Ban, error) {
+	query := `
+		SELECT id, room_id, user_id, banned_by, COALESCE(reason, ''), expires_at, created_at
+		FROM room_bans
+		WHERE room_id = $1
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bans []*room.Ban
+	for rows.Next() {
+		var ban room.Ban
+		err := rows.Scan(
+			&ban.ID,
+			&ban.RoomID,
+			&ban.UserID,
+			&ban.BannedBy,
+			&ban.Reason,
+			&ban.ExpiresAt,
+			&ban.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, &ban)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bans, nil
+}
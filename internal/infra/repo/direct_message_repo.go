@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// DirectMessageRepository implementa chat.DirectMessageRepository
+type DirectMessageRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDirectMessageRepository cria uma nova instância do repositório.
+func NewDirectMessageRepository(pool *pgxpool.Pool) *DirectMessageRepository {
+	return &DirectMessageRepository{pool: pool}
+}
+
+// Create salva uma nova mensagem direta.
+func (r *DirectMessageRepository) Create(ctx context.Context, dm *chat.DirectMessage) error {
+	query := `
+		INSERT INTO direct_messages (id, from_user_id, to_user_id, content, read_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		dm.ID,
+		dm.FromUserID,
+		dm.ToUserID,
+		dm.Content,
+		dm.ReadAt,
+		dm.CreatedAt,
+	)
+	return err
+}
+
+// ListConversation retorna as mensagens entre dois usuários, das mais recentes para as mais antigas.
+// Com before, retorna apenas as anteriores a esse cursor.
+func (r *DirectMessageRepository) ListConversation(ctx context.Context, userA, userB user.ID, before *chat.Cursor, limit int) ([]*chat.DirectMessage, error) {
+	// LEAST/GREATEST usam o índice da conversa
+	query := `
+		SELECT id, from_user_id, to_user_id, content, read_at, created_at
+		FROM direct_messages
+		WHERE LEAST(from_user_id, to_user_id) = LEAST($1::uuid, $2::uuid)
+		  AND GREATEST(from_user_id, to_user_id) = GREATEST($1::uuid, $2::uuid)
+		  AND ($3::timestamptz IS NULL OR (created_at, id) < ($3, $4::uuid))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $5
+	`
+
+	// O id desempata mensagens com o mesmo created_at
+	var beforeAt *time.Time
+	var beforeID *string
+	if before != nil {
+		beforeAt, beforeID = &before.CreatedAt, &before.ID
+	}
+
+	rows, err := r.pool.Query(ctx, query, userA, userB, beforeAt, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*chat.DirectMessage
+	for rows.Next() {
+		var dm chat.DirectMessage
+		err := rows.Scan(
+			&dm.ID,
+			&dm.FromUserID,
+			&dm.ToUserID,
+			&dm.Content,
+			&dm.ReadAt,
+			&dm.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &dm)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// MarkAsRead marca como lidas todas as mensagens de fromUserID para toUserID.
+func (r *DirectMessageRepository) MarkAsRead(ctx context.Context, fromUserID, toUserID user.ID) error {
+	query := `
+		UPDATE direct_messages
+		SET read_at = NOW()
+		WHERE from_user_id = $1 AND to_user_id = $2 AND read_at IS NULL
+	`
+
+	_, err := r.pool.Exec(ctx, query, fromUserID, toUserID)
+	return err
+}
+
+// CountUnread conta as mensagens não lidas recebidas pelo usuário.
+func (r *DirectMessageRepository) CountUnread(ctx context.Context, userID user.ID) (int, error) {
+	query := `SELECT COUNT(*) FROM direct_messages WHERE to_user_id = $1 AND read_at IS NULL`
+
+	var count int
+	err := r.pool.QueryRow(ctx, query, userID).Scan(&count)
+	return count, err
+}
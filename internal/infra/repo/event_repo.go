@@ -0,0 +1,310 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// EventRepository implementa room.EventRepository
+type EventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEventRepository cria uma nova instância do repositório.
+func NewEventRepository(pool *pgxpool.Pool) *EventRepository {
+	return &EventRepository{pool: pool}
+}
+
+// eventColumns são as colunas lidas por scanEvent.
+const eventColumns = `
+	e.id, e.room_id, e.created_by, e.title, e.description, e.starts_at,
+	e.media_url, e.media_title, e.reminder_sent_at, e.started_at, e.created_at, e.updated_at
+`
+
+// Create salva uma nova sessão agendada.
+func (r *EventRepository) Create(ctx context.Context, e *room.Event) error {
+	query := `
+		INSERT INTO room_events (id, room_id, created_by, title, description, starts_at,
+		                         media_url, media_title, reminder_sent_at, started_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		e.ID,
+		e.RoomID,
+		e.CreatedBy,
+		e.Title,
+		e.Description,
+		e.StartsAt,
+		e.MediaURL,
+		e.MediaTitle,
+		e.ReminderSentAt,
+		e.StartedAt,
+		e.CreatedAt,
+		e.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetByID busca uma sessão pelo ID.
+func (r *EventRepository) GetByID(ctx context.Context, id room.EventID) (*room.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM room_events e WHERE e.id = $1`
+
+	e, err := scanEvent(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrEventNotFound
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Update atualiza os dados de uma sessão existente.
+// reminder_sent_at e started_at ficam com o agendador (MarkReminderSent e MarkStarted),
+// para que uma edição não desfaça o que ele gravou nesse meio tempo.
+func (r *EventRepository) Update(ctx context.Context, e *room.Event) error {
+	query := `
+		UPDATE room_events
+		SET title = $2,
+		    description = $3,
+		    reminder_sent_at = CASE WHEN starts_at = $4 THEN reminder_sent_at END,
+		    starts_at = $4,
+		    media_url = $5,
+		    media_title = $6,
+		    updated_at = $7
+		WHERE id = $1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		e.ID,
+		e.Title,
+		e.Description,
+		e.StartsAt,
+		e.MediaURL,
+		e.MediaTitle,
+		e.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrEventNotFound
+	}
+
+	return nil
+}
+
+// MarkReminderSent registra o envio do lembrete se ele ainda não foi enviado.
+// Só uma instância consegue marcar, então só ela envia.
+func (r *EventRepository) MarkReminderSent(ctx context.Context, id room.EventID) (bool, error) {
+	query := `
+		UPDATE room_events
+		SET reminder_sent_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1 AND reminder_sent_at IS NULL AND started_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// MarkStarted registra o início da sessão se ela ainda não começou.
+func (r *EventRepository) MarkStarted(ctx context.Context, id room.EventID) (bool, error) {
+	query := `
+		UPDATE room_events
+		SET started_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $1 AND started_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// Delete remove uma sessão e suas respostas.
+func (r *EventRepository) Delete(ctx context.Context, id room.EventID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM room_events WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrEventNotFound
+	}
+
+	return nil
+}
+
+// ListByRoom lista as sessões de uma sala que começam a partir de since.
+func (r *EventRepository) ListByRoom(ctx context.Context, roomID room.ID, since time.Time) ([]*room.Event, error) {
+	query := `
+		SELECT ` + eventColumns + `
+		FROM room_events e
+		WHERE e.room_id = $1 AND e.starts_at >= $2
+		ORDER BY e.starts_at ASC
+	`
+
+	return r.queryEvents(ctx, query, roomID, since)
+}
+
+// ListDueReminders lista as sessões ainda sem lembrete que começam até before.
+func (r *EventRepository) ListDueReminders(ctx context.Context, before time.Time) ([]*room.Event, error) {
+	query := `
+		SELECT ` + eventColumns + `
+		FROM room_events e
+		JOIN rooms ON rooms.id = e.room_id AND rooms.deleted_at IS NULL
+		WHERE e.reminder_sent_at IS NULL
+		  AND e.started_at IS NULL
+		  AND e.starts_at <= $1
+		ORDER BY e.starts_at ASC
+	`
+
+	return r.queryEvents(ctx, query, before)
+}
+
+// ListDueStarts lista as sessões não iniciadas cujo horário já chegou.
+func (r *EventRepository) ListDueStarts(ctx context.Context, now time.Time) ([]*room.Event, error) {
+	query := `
+		SELECT ` + eventColumns + `
+		FROM room_events e
+		JOIN rooms ON rooms.id = e.room_id AND rooms.deleted_at IS NULL
+		WHERE e.started_at IS NULL
+		  AND e.starts_at <= $1
+		ORDER BY e.starts_at ASC
+	`
+
+	return r.queryEvents(ctx, query, now)
+}
+
+// SaveRSVP cria ou atualiza a resposta de um usuário.
+func (r *EventRepository) SaveRSVP(ctx context.Context, rsvp *room.RSVP) error {
+	query := `
+		INSERT INTO room_event_rsvps (event_id, user_id, status, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_id, user_id)
+		DO UPDATE SET status = EXCLUDED.status,
+		              updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		rsvp.EventID,
+		rsvp.UserID,
+		rsvp.Status,
+		rsvp.UpdatedAt,
+	)
+
+	return err
+}
+
+// DeleteRSVP remove a resposta de um usuário.
+func (r *EventRepository) DeleteRSVP(ctx context.Context, eventID room.EventID, userID user.ID) error {
+	query := `DELETE FROM room_event_rsvps WHERE event_id = $1 AND user_id = $2`
+
+	result, err := r.pool.Exec(ctx, query, eventID, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrRSVPNotFound
+	}
+
+	return nil
+}
+
+// ListRSVPs lista as respostas de uma sessão.
+func (r *EventRepository) ListRSVPs(ctx context.Context, eventID room.EventID) ([]*room.RSVP, error) {
+	query := `
+		SELECT event_id, user_id, status, updated_at
+		FROM room_event_rsvps
+		WHERE event_id = $1
+		ORDER BY updated_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rsvps []*room.RSVP
+	for rows.Next() {
+		var rsvp room.RSVP
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.UpdatedAt); err != nil {
+			return nil, err
+		}
+		rsvps = append(rsvps, &rsvp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rsvps, nil
+}
+
+// queryEvents executa uma consulta que retorna várias sessões.
+func (r *EventRepository) queryEvents(ctx context.Context, query string, args ...interface{}) ([]*room.Event, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*room.Event
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// scanEvent converte uma linha do banco em Event.
+func scanEvent(row pgx.Row) (*room.Event, error) {
+	var e room.Event
+	err := row.Scan(
+		&e.ID,
+		&e.RoomID,
+		&e.CreatedBy,
+		&e.Title,
+		&e.Description,
+		&e.StartsAt,
+		&e.MediaURL,
+		&e.MediaTitle,
+		&e.ReminderSentAt,
+		&e.StartedAt,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
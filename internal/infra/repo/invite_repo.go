@@ -0,0 +1,228 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// InviteRepository implementa room.InviteRepository
+type InviteRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewInviteRepository cria uma nova instância do repositório.
+func NewInviteRepository(pool *pgxpool.Pool) *InviteRepository {
+	return &InviteRepository{pool: pool}
+}
+
+// inviteColumns são as colunas lidas por scanInvite.
+const inviteColumns = `
+	id, room_id, token, created_by, max_uses, uses, COALESCE(seat_id, ''),
+	expires_at, revoked_at, created_at
+`
+
+// Create salva um novo convite.
+func (r *InviteRepository) Create(ctx context.Context, i *room.Invite) error {
+	query := `
+		INSERT INTO room_invites (id, room_id, token, created_by, max_uses, uses, seat_id,
+		                          expires_at, revoked_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		i.ID,
+		i.RoomID,
+		i.Token,
+		i.CreatedBy,
+		i.MaxUses,
+		i.Uses,
+		i.SeatID,
+		i.ExpiresAt,
+		i.RevokedAt,
+		i.CreatedAt,
+	)
+
+	return err
+}
+
+// GetByID busca um convite pelo ID.
+func (r *InviteRepository) GetByID(ctx context.Context, id room.InviteID) (*room.Invite, error) {
+	query := `SELECT ` + inviteColumns + ` FROM room_invites WHERE id = $1`
+
+	return r.getOne(ctx, query, id)
+}
+
+// GetByToken busca um convite pelo token.
+func (r *InviteRepository) GetByToken(ctx context.Context, token string) (*room.Invite, error) {
+	query := `SELECT ` + inviteColumns + ` FROM room_invites WHERE token = $1`
+
+	return r.getOne(ctx, query, token)
+}
+
+// ListByRoom lista os convites de uma sala.
+func (r *InviteRepository) ListByRoom(ctx context.Context, roomID room.ID) ([]*room.Invite, error) {
+	query := `
+		SELECT ` + inviteColumns + `
+		FROM room_invites
+		WHERE room_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []*room.Invite
+	for rows.Next() {
+		i, err := scanInvite(rows)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// Update atualiza um convite existente.
+func (r *InviteRepository) Update(ctx context.Context, i *room.Invite) error {
+	query := `
+		UPDATE room_invites
+		SET max_uses = $2,
+		    seat_id = NULLIF($3, ''),
+		    expires_at = $4,
+		    revoked_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		i.ID,
+		i.MaxUses,
+		i.SeatID,
+		i.ExpiresAt,
+		i.RevokedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrInviteNotFound
+	}
+
+	return nil
+}
+
+// Accept registra um uso do convite e grava o membro na mesma transação.
+func (r *InviteRepository) Accept(ctx context.Context, id room.InviteID, m *room.Member) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE room_invites
+		SET uses = uses + 1
+		WHERE id = $1
+		  AND revoked_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		  AND (max_uses = 0 OR uses < max_uses)
+	`, id)
+	if err != nil {
+		return false, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return false, room.ErrInviteExhausted
+	}
+
+	seatReserved := false
+	if m.SeatID != "" {
+		// Savepoint: se o assento já for de outro, a transação continua sem ele
+		sp, err := tx.Begin(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = sp.Exec(ctx, `
+			INSERT INTO room_members (room_id, user_id, role, seat_id, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (room_id, user_id)
+			DO UPDATE SET seat_id = EXCLUDED.seat_id,
+			              updated_at = EXCLUDED.updated_at
+		`, m.RoomID, m.UserID, m.Role, m.SeatID, m.CreatedAt, m.UpdatedAt)
+		switch {
+		case err == nil:
+			if err := sp.Commit(ctx); err != nil {
+				return false, err
+			}
+			seatReserved = true
+		case isDuplicateKeyError(err):
+			if err := sp.Rollback(ctx); err != nil {
+				return false, err
+			}
+		default:
+			return false, err
+		}
+	}
+
+	if !seatReserved {
+		// Quem já é membro fica como está (papel e assento)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO room_members (room_id, user_id, role, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (room_id, user_id) DO NOTHING
+		`, m.RoomID, m.UserID, m.Role, m.CreatedAt, m.UpdatedAt)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return seatReserved, tx.Commit(ctx)
+}
+
+// getOne busca um único convite.
+func (r *InviteRepository) getOne(ctx context.Context, query string, arg interface{}) (*room.Invite, error) {
+	i, err := scanInvite(r.pool.QueryRow(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrInviteNotFound
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// scanInvite converte uma linha do banco em Invite.
+func scanInvite(row pgx.Row) (*room.Invite, error) {
+	var i room.Invite
+	err := row.Scan(
+		&i.ID,
+		&i.RoomID,
+		&i.Token,
+		&i.CreatedBy,
+		&i.MaxUses,
+		&i.Uses,
+		&i.SeatID,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// MemberRepository implementa room.MemberRepository
+type MemberRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMemberRepository cria uma nova instância do repositório.
+func NewMemberRepository(pool *pgxpool.Pool) *MemberRepository {
+	return &MemberRepository{pool: pool}
+}
+
+// Save cria ou atualiza o registro de um membro.
+func (r *MemberRepository) Save(ctx context.Context, m *room.Member) error {
+	query := `
+		INSERT INTO room_members (room_id, user_id, role, seat_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		ON CONFLICT (room_id, user_id)
+		DO UPDATE SET role = EXCLUDED.role,
+		              seat_id = EXCLUDED.seat_id,
+		              updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		m.RoomID,
+		m.UserID,
+		m.Role,
+		m.SeatID,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+	if err != nil {
+		// A única chave que pode repetir é a do assento (idx_room_members_seat)
+		if isDuplicateKeyError(err) {
+			return room.ErrSeatTaken
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Get busca o registro de um usuário em uma sala.
+func (r *MemberRepository) Get(ctx context.Context, roomID room.ID, userID user.ID) (*room.Member, error) {
+	query := `
+		SELECT room_id, user_id, role, COALESCE(seat_id, ''), created_at, updated_at
+		FROM room_members
+		WHERE room_id = $1 AND user_id = $2
+	`
+
+	var m room.Member
+	err := r.pool.QueryRow(ctx, query, roomID, userID).Scan(
+		&m.RoomID,
+		&m.UserID,
+		&m.Role,
+		&m.SeatID,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrMemberNotFound
+		}
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// ListByRoom lista os membros de uma sala.
+func (r *MemberRepository) ListByRoom(ctx context.Context, roomID room.ID) ([]*room.Member, error) {
+	query := `
+		SELECT room_id, user_id, role, COALESCE(seat_id, ''), created_at, updated_at
+		FROM room_members
+		WHERE room_id = $1
+		ORDER BY CASE role
+		             WHEN 'co_host' THEN 0
+		             WHEN 'moderator' THEN 1
+		             ELSE 2
+		         END,
+		         created_at ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*room.Member
+	for rows.Next() {
+		var m room.Member
+		err := rows.Scan(
+			&m.RoomID,
+			&m.UserID,
+			&m.Role,
+			&m.SeatID,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
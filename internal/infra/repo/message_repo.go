@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// MessageRepository implementa chat.MessageRepository
+type MessageRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMessageRepository cria uma nova instância do repositório.
+func NewMessageRepository(pool *pgxpool.Pool) *MessageRepository {
+	return &MessageRepository{pool: pool}
+}
+
+// Create salva uma nova mensagem de sala.
+func (r *MessageRepository) Create(ctx context.Context, msg *chat.Message) error {
+	query := `
+		INSERT INTO chat_messages (id, room_id, user_id, content, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		msg.ID,
+		msg.RoomID,
+		msg.UserID,
+		msg.Content,
+		msg.CreatedAt,
+	)
+	return err
+}
+
+// ListByRoom retorna as mensagens de uma sala, das mais recentes para as mais antigas.
+// Com before, retorna apenas as anteriores a esse cursor.
+func (r *MessageRepository) ListByRoom(ctx context.Context, roomID room.ID, before *chat.Cursor, limit int) ([]*chat.Message, error) {
+	query := `
+		SELECT id, room_id, user_id, content, created_at
+		FROM chat_messages
+		WHERE room_id = $1
+		  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2, $3::uuid))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4
+	`
+
+	// O id desempata mensagens com o mesmo created_at
+	var beforeAt *time.Time
+	var beforeID *string
+	if before != nil {
+		beforeAt, beforeID = &before.CreatedAt, &before.ID
+	}
+
+	rows, err := r.pool.Query(ctx, query, roomID, beforeAt, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*chat.Message
+	for rows.Next() {
+		var msg chat.Message
+		err := rows.Scan(
+			&msg.ID,
+			&msg.RoomID,
+			&msg.UserID,
+			&msg.Content,
+			&msg.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
@@ -3,6 +3,9 @@ package repo
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -23,18 +26,27 @@ func NewRoomRepository(pool *pgxpool.Pool) *RoomRepository {
 // Create salva uma nova sala no banco.
 func (r *RoomRepository) Create(ctx context.Context, rm *room.Room) error {
 	query := `
-		INSERT INTO rooms (id, owner_id, name, theme, visibility, access_code, max_seats, created_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO rooms (id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	settings, err := encodeRoomSettings(rm.Settings)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query,
 		rm.ID,
-		rm.OwnerID,
 		rm.Name,
 		rm.Theme,
 		rm.Visibility,
 		rm.AccessCode,
 		rm.MaxSeats,
+		tagsOrEmpty(rm.Tags),
+		rm.Category,
+		rm.Language,
+		rm.ContentRating,
+		settings,
 		rm.CreatedAt,
 		rm.UpdatedAt,
 		rm.DeletedAt,
@@ -46,7 +58,7 @@ func (r *RoomRepository) Create(ctx context.Context, rm *room.Room) error {
 // GetByID busca uma sala pelo ID.
 func (r *RoomRepository) GetByID(ctx context.Context, id room.ID) (*room.Room, error) {
 	query := `
-		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, created_at, updated_at, deleted_at
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
 		FROM rooms
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -57,9 +69,9 @@ func (r *RoomRepository) GetByID(ctx context.Context, id room.ID) (*room.Room, e
 // GetByAccessCode busca uma sala pelo código de acesso.
 func (r *RoomRepository) GetByAccessCode(ctx context.Context, code string) (*room.Room, error) {
 	query := `
-		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, created_at, updated_at, deleted_at
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
 		FROM rooms
-		WHERE UPPER(access_code) = UPPER($1) AND deleted_at IS NULL
+		WHERE access_code = $1 AND deleted_at IS NULL
 	`
 
 	return r.scanRoom(r.pool.QueryRow(ctx, query, code))
@@ -67,6 +79,8 @@ func (r *RoomRepository) GetByAccessCode(ctx context.Context, code string) (*roo
 
 // Update atualiza os dados de uma sala existente.
 func (r *RoomRepository) Update(ctx context.Context, rm *room.Room) error {
+	// owner_id fica de fora: só TransferRepository.Accept troca o dono,
+	// assim uma edição concorrente não desfaz a transferência
 	query := `
 		UPDATE rooms
 		SET name = $2,
@@ -74,11 +88,21 @@ func (r *RoomRepository) Update(ctx context.Context, rm *room.Room) error {
 		    visibility = $4,
 		    access_code = $5,
 		    max_seats = $6,
-		    updated_at = $7,
-		    deleted_at = $8
+		    tags = $7,
+		    category = $8,
+		    language = $9,
+		    content_rating = $10,
+		    settings = $11,
+		    updated_at = $12,
+		    deleted_at = $13
 		WHERE id = $1
 	`
 
+	settings, err := encodeRoomSettings(rm.Settings)
+	if err != nil {
+		return err
+	}
+
 	result, err := r.pool.Exec(ctx, query,
 		rm.ID,
 		rm.Name,
@@ -86,6 +110,11 @@ func (r *RoomRepository) Update(ctx context.Context, rm *room.Room) error {
 		rm.Visibility,
 		rm.AccessCode,
 		rm.MaxSeats,
+		tagsOrEmpty(rm.Tags),
+		rm.Category,
+		rm.Language,
+		rm.ContentRating,
+		settings,
 		rm.UpdatedAt,
 		rm.DeletedAt,
 	)
@@ -101,17 +130,57 @@ func (r *RoomRepository) Update(ctx context.Context, rm *room.Room) error {
 	return nil
 }
 
-// ListPublic retorna todas as salas públicas não deletadas.
-func (r *RoomRepository) ListPublic(ctx context.Context, limit, offset int) ([]*room.Room, error) {
+// ListPublic retorna as salas públicas (e com aprovação) não deletadas que atendem ao filtro.
+func (r *RoomRepository) ListPublic(ctx context.Context, filter room.PublicFilter) ([]*room.Room, error) {
+	conditions := []string{"visibility <> 'private'", "deleted_at IS NULL"}
+	var args []interface{}
+
+	// arg adiciona um parâmetro e retorna o placeholder ($n)
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.Theme != "" {
+		conditions = append(conditions, "theme = "+arg(filter.Theme))
+	}
+	if filter.Query != "" {
+		conditions = append(conditions, "name ILIKE "+arg("%"+escapeLike(filter.Query)+"%"))
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, "tags @> "+arg(filter.Tags)+"::text[]")
+	}
+	if filter.Category != "" {
+		conditions = append(conditions, "category = "+arg(filter.Category))
+	}
+	if filter.Language != "" {
+		p := arg(filter.Language)
+		conditions = append(conditions, "(language = "+p+" OR language LIKE "+p+" || '-%')")
+	}
+	if filter.Rating != "" {
+		conditions = append(conditions, "content_rating = "+arg(filter.Rating))
+	}
+	if filter.HideAdult {
+		conditions = append(conditions, "content_rating <> '"+string(room.Rating18)+"'")
+	}
+	if filter.IDs != nil {
+		conditions = append(conditions, "id = ANY("+arg(idStrings(filter.IDs))+"::uuid[])")
+	}
+	if len(filter.ExcludeIDs) > 0 {
+		conditions = append(conditions, "NOT (id = ANY("+arg(idStrings(filter.ExcludeIDs))+"::uuid[]))")
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "(created_at, id) < ("+arg(filter.After.CreatedAt)+", "+arg(filter.After.ID)+")")
+	}
+
 	query := `
-		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, created_at, updated_at, deleted_at
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
 		FROM rooms
-		WHERE visibility = 'public' AND deleted_at IS NULL
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + arg(filter.Limit) + ` OFFSET ` + arg(filter.Offset)
 
-	rows, err := r.pool.Query(ctx, query, limit, offset)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +192,7 @@ func (r *RoomRepository) ListPublic(ctx context.Context, limit, offset int) ([]*
 // ListByOwner retorna todas as salas de um usuário.
 func (r *RoomRepository) ListByOwner(ctx context.Context, ownerID user.ID) ([]*room.Room, error) {
 	query := `
-		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, created_at, updated_at, deleted_at
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
 		FROM rooms
 		WHERE owner_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at DESC
@@ -151,9 +220,126 @@ func (r *RoomRepository) CountByOwner(ctx context.Context, ownerID user.ID) (int
 	return count, nil
 }
 
+// GetDeletedByID busca uma sala deletada pelo ID.
+func (r *RoomRepository) GetDeletedByID(ctx context.Context, id room.ID) (*room.Room, error) {
+	query := `
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
+		FROM rooms
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	return r.scanRoom(r.pool.QueryRow(ctx, query, id))
+}
+
+// ListDeletedByOwner retorna as salas de um usuário deletadas depois de since.
+func (r *RoomRepository) ListDeletedByOwner(ctx context.Context, ownerID user.ID, since time.Time) ([]*room.Room, error) {
+	query := `
+		SELECT id, owner_id, name, theme, visibility, access_code, max_seats, tags, category, language, content_rating, settings, created_at, updated_at, deleted_at
+		FROM rooms
+		WHERE owner_id = $1 AND deleted_at IS NOT NULL AND deleted_at > $2
+		ORDER BY deleted_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ownerID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanRooms(rows)
+}
+
+// PurgeDeleted apaga de vez as salas deletadas antes de before.
+// Os dados ligados à sala são apagados explicitamente (e não só pelo CASCADE)
+// para que a quantidade de cada um possa ser contada.
+func (r *RoomRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (room.PurgeResult, error) {
+	var result room.PurgeResult
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Travar o lote (outras instâncias pulam as salas já travadas)
+	rows, err := tx.Query(ctx, `
+		SELECT id
+		FROM rooms
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		ORDER BY deleted_at ASC
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, before, limit)
+	if err != nil {
+		return result, err
+	}
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return result, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return result, err
+	}
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	steps := []struct {
+		query   string
+		counter *int64
+	}{
+		{`DELETE FROM chat_messages WHERE room_id = ANY($1)`, &result.ChatMessages},
+		{`DELETE FROM room_bans WHERE room_id = ANY($1)`, &result.Bans},
+		{`DELETE FROM room_invites WHERE room_id = ANY($1)`, &result.Invites},
+		{`DELETE FROM room_members WHERE room_id = ANY($1)`, &result.Members},
+		{`DELETE FROM room_events WHERE room_id = ANY($1)`, &result.Events},
+		{`DELETE FROM rooms WHERE id = ANY($1)`, &result.Rooms},
+	}
+
+	for _, step := range steps {
+		tag, err := tx.Exec(ctx, step.query, ids)
+		if err != nil {
+			return room.PurgeResult{}, err
+		}
+		*step.counter = tag.RowsAffected()
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return room.PurgeResult{}, err
+	}
+
+	return result, nil
+}
+
+// idStrings converte uma lista de IDs de sala para strings.
+func idStrings(ids []room.ID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
+	}
+	return result
+}
+
+// tagsOrEmpty evita gravar NULL na coluna de tags.
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
 // scanRoom converte uma linha do banco em um Room.
 func (r *RoomRepository) scanRoom(row pgx.Row) (*room.Room, error) {
 	var rm room.Room
+	var settings []byte
 
 	err := row.Scan(
 		&rm.ID,
@@ -163,6 +349,11 @@ func (r *RoomRepository) scanRoom(row pgx.Row) (*room.Room, error) {
 		&rm.Visibility,
 		&rm.AccessCode,
 		&rm.MaxSeats,
+		&rm.Tags,
+		&rm.Category,
+		&rm.Language,
+		&rm.ContentRating,
+		&settings,
 		&rm.CreatedAt,
 		&rm.UpdatedAt,
 		&rm.DeletedAt,
@@ -175,6 +366,10 @@ func (r *RoomRepository) scanRoom(row pgx.Row) (*room.Room, error) {
 		return nil, err
 	}
 
+	if rm.Settings, err = decodeRoomSettings(settings); err != nil {
+		return nil, err
+	}
+
 	return &rm, nil
 }
 
@@ -184,6 +379,7 @@ func (r *RoomRepository) scanRooms(rows pgx.Rows) ([]*room.Room, error) {
 
 	for rows.Next() {
 		var rm room.Room
+		var settings []byte
 		err := rows.Scan(
 			&rm.ID,
 			&rm.OwnerID,
@@ -192,6 +388,11 @@ func (r *RoomRepository) scanRooms(rows pgx.Rows) ([]*room.Room, error) {
 			&rm.Visibility,
 			&rm.AccessCode,
 			&rm.MaxSeats,
+			&rm.Tags,
+			&rm.Category,
+			&rm.Language,
+			&rm.ContentRating,
+			&settings,
 			&rm.CreatedAt,
 			&rm.UpdatedAt,
 			&rm.DeletedAt,
@@ -199,6 +400,9 @@ func (r *RoomRepository) scanRooms(rows pgx.Rows) ([]*room.Room, error) {
 		if err != nil {
 			return nil, err
 		}
+		if rm.Settings, err = decodeRoomSettings(settings); err != nil {
+			return nil, err
+		}
 		rooms = append(rooms, &rm)
 	}
 
@@ -0,0 +1,35 @@
+package repo
+
+import (
+	"encoding/json"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// roomSettingsDocument é o formato JSON das configurações salvas em rooms.settings.
+type roomSettingsDocument struct {
+	SlowModeSeconds int               `json:"slow_mode_seconds"`
+	ChatEnabled     bool              `json:"chat_enabled"`
+	LinksAllowed    bool              `json:"links_allowed"`
+	MediaControl    room.MediaControl `json:"media_control"`
+	GuestsAllowed   bool              `json:"guests_allowed"`
+}
+
+// encodeRoomSettings converte as configurações da sala para JSON.
+func encodeRoomSettings(s room.Settings) ([]byte, error) {
+	return json.Marshal(roomSettingsDocument(s))
+}
+
+// decodeRoomSettings converte o JSON salvo em configurações da sala.
+// Campos ausentes (salas antigas) ficam com o valor padrão.
+func decodeRoomSettings(data []byte) (room.Settings, error) {
+	doc := roomSettingsDocument(room.DefaultSettings())
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return room.Settings{}, err
+		}
+	}
+
+	return room.Settings(doc), nil
+}
@@ -0,0 +1,233 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// ThemeRepository implementa room.ThemeRepository
+type ThemeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewThemeRepository cria uma nova instância do repositório.
+func NewThemeRepository(pool *pgxpool.Pool) *ThemeRepository {
+	return &ThemeRepository{pool: pool}
+}
+
+// List retorna todos os temas.
+func (r *ThemeRepository) List(ctx context.Context) ([]*room.ThemeDefinition, error) {
+	query := `
+		SELECT id, display_name, assets, palette, seat_columns, seat_aisles,
+		       COALESCE(unlock_achievement, ''), sort_order, created_at, updated_at
+		FROM themes
+		ORDER BY sort_order ASC, id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var themes []*room.ThemeDefinition
+	for rows.Next() {
+		t, err := r.scanTheme(rows)
+		if err != nil {
+			return nil, err
+		}
+		themes = append(themes, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return themes, nil
+}
+
+// GetByID busca um tema pelo ID.
+func (r *ThemeRepository) GetByID(ctx context.Context, id room.Theme) (*room.ThemeDefinition, error) {
+	query := `
+		SELECT id, display_name, assets, palette, seat_columns, seat_aisles,
+		       COALESCE(unlock_achievement, ''), sort_order, created_at, updated_at
+		FROM themes
+		WHERE id = $1
+	`
+
+	t, err := r.scanTheme(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrThemeNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// Create salva um novo tema.
+func (r *ThemeRepository) Create(ctx context.Context, t *room.ThemeDefinition) error {
+	query := `
+		INSERT INTO themes (id, display_name, assets, palette, seat_columns, seat_aisles,
+		                    unlock_achievement, sort_order, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10)
+	`
+
+	assets, palette, err := encodeThemeMaps(t)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query,
+		t.ID,
+		t.DisplayName,
+		assets,
+		palette,
+		t.SeatColumns,
+		themeAisles(t),
+		t.UnlockAchievement,
+		t.SortOrder,
+		t.CreatedAt,
+		t.UpdatedAt,
+	)
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return room.ErrThemeExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Update atualiza um tema existente.
+func (r *ThemeRepository) Update(ctx context.Context, t *room.ThemeDefinition) error {
+	query := `
+		UPDATE themes
+		SET display_name = $2,
+		    assets = $3,
+		    palette = $4,
+		    seat_columns = $5,
+		    seat_aisles = $6,
+		    unlock_achievement = NULLIF($7, ''),
+		    sort_order = $8,
+		    updated_at = $9
+		WHERE id = $1
+	`
+
+	assets, palette, err := encodeThemeMaps(t)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query,
+		t.ID,
+		t.DisplayName,
+		assets,
+		palette,
+		t.SeatColumns,
+		themeAisles(t),
+		t.UnlockAchievement,
+		t.SortOrder,
+		t.UpdatedAt,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrThemeNotFound
+	}
+
+	return nil
+}
+
+// Delete remove um tema.
+func (r *ThemeRepository) Delete(ctx context.Context, id room.Theme) error {
+	query := `DELETE FROM themes WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return room.ErrThemeInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return room.ErrThemeNotFound
+	}
+
+	return nil
+}
+
+// scanTheme converte uma linha do banco em um ThemeDefinition.
+func (r *ThemeRepository) scanTheme(row pgx.Row) (*room.ThemeDefinition, error) {
+	var t room.ThemeDefinition
+	var assets, palette []byte
+
+	err := row.Scan(
+		&t.ID,
+		&t.DisplayName,
+		&assets,
+		&palette,
+		&t.SeatColumns,
+		&t.SeatAisles,
+		&t.UnlockAchievement,
+		&t.SortOrder,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(assets, &t.Assets); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(palette, &t.Palette); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// encodeThemeMaps converte assets e cores para JSON.
+func encodeThemeMaps(t *room.ThemeDefinition) (assets, palette []byte, err error) {
+	if assets, err = json.Marshal(nonNilMap(t.Assets)); err != nil {
+		return nil, nil, err
+	}
+	if palette, err = json.Marshal(nonNilMap(t.Palette)); err != nil {
+		return nil, nil, err
+	}
+	return assets, palette, nil
+}
+
+// nonNilMap evita salvar null no lugar de um objeto vazio.
+func nonNilMap(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
+
+// themeAisles evita salvar NULL no lugar de uma lista vazia.
+func themeAisles(t *room.ThemeDefinition) []int {
+	if t.SeatAisles == nil {
+		return []int{}
+	}
+	return t.SeatAisles
+}
+
+// isForeignKeyError verifica se o erro é de chave estrangeira.
+func isForeignKeyError(err error) bool {
+	// O código de erro do PostgreSQL para foreign key violation é 23503
+	return err != nil && contains(err.Error(), "23503")
+}
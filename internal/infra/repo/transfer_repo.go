@@ -0,0 +1,203 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// TransferRepository implementa room.TransferRepository
+type TransferRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTransferRepository cria uma nova instância do repositório.
+func NewTransferRepository(pool *pgxpool.Pool) *TransferRepository {
+	return &TransferRepository{pool: pool}
+}
+
+// Save cria a transferência pendente da sala, substituindo a anterior.
+func (r *TransferRepository) Save(ctx context.Context, t *room.Transfer) error {
+	query := `
+		INSERT INTO room_transfers (room_id, from_user_id, to_user_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id)
+		DO UPDATE SET from_user_id = EXCLUDED.from_user_id,
+		              to_user_id = EXCLUDED.to_user_id,
+		              expires_at = EXCLUDED.expires_at,
+		              created_at = EXCLUDED.created_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		t.RoomID,
+		t.FromUserID,
+		t.ToUserID,
+		t.ExpiresAt,
+		t.CreatedAt,
+	)
+
+	return err
+}
+
+// GetByRoom busca a transferência pendente de uma sala.
+func (r *TransferRepository) GetByRoom(ctx context.Context, roomID room.ID) (*room.Transfer, error) {
+	query := `
+		SELECT room_id, from_user_id, to_user_id, expires_at, created_at
+		FROM room_transfers
+		WHERE room_id = $1
+	`
+
+	var t room.Transfer
+	err := r.pool.QueryRow(ctx, query, roomID).Scan(
+		&t.RoomID,
+		&t.FromUserID,
+		&t.ToUserID,
+		&t.ExpiresAt,
+		&t.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, room.ErrTransferNotFound
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// ListByRecipient lista as transferências não expiradas oferecidas ao usuário.
+func (r *TransferRepository) ListByRecipient(ctx context.Context, userID user.ID) ([]*room.Transfer, error) {
+	query := `
+		SELECT room_id, from_user_id, to_user_id, expires_at, created_at
+		FROM room_transfers
+		WHERE to_user_id = $1 AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*room.Transfer
+	for rows.Next() {
+		var t room.Transfer
+		err := rows.Scan(
+			&t.RoomID,
+			&t.FromUserID,
+			&t.ToUserID,
+			&t.ExpiresAt,
+			&t.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Delete remove a transferência pendente de uma sala.
+func (r *TransferRepository) Delete(ctx context.Context, roomID room.ID) error {
+	query := `DELETE FROM room_transfers WHERE room_id = $1`
+
+	_, err := r.pool.Exec(ctx, query, roomID)
+	return err
+}
+
+// Accept aplica a transferência em uma única transação.
+// O destinatário e a sala ficam travados até o fim, para que duas aceitações
+// simultâneas não passem do limite de salas nem troquem o dono duas vezes.
+func (r *TransferRepository) Accept(ctx context.Context, t *room.Transfer, previousOwner *room.Member, maxRooms int) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, t.ToUserID); err != nil {
+		return err
+	}
+
+	var ownerID user.ID
+	err = tx.QueryRow(ctx, `
+		SELECT owner_id
+		FROM rooms
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, t.RoomID).Scan(&ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return room.ErrRoomNotFound
+		}
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `
+		DELETE FROM room_transfers
+		WHERE room_id = $1 AND from_user_id = $2 AND to_user_id = $3
+	`, t.RoomID, t.FromUserID, t.ToUserID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return room.ErrTransferNotFound
+	}
+
+	// A oferta perde a validade se a sala mudou de dono depois dela
+	if ownerID != t.FromUserID {
+		if err := tx.Commit(ctx); err != nil {
+			return err
+		}
+		return room.ErrTransferNotFound
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM rooms WHERE owner_id = $1 AND deleted_at IS NULL
+	`, t.ToUserID).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count >= maxRooms {
+		return room.ErrRoomLimitReached
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE rooms SET owner_id = $2, updated_at = NOW() WHERE id = $1
+	`, t.RoomID, t.ToUserID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO room_members (room_id, user_id, role, seat_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		ON CONFLICT (room_id, user_id)
+		DO UPDATE SET role = EXCLUDED.role,
+		              updated_at = EXCLUDED.updated_at
+	`,
+		previousOwner.RoomID,
+		previousOwner.UserID,
+		previousOwner.Role,
+		previousOwner.SeatID,
+		previousOwner.CreatedAt,
+		previousOwner.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
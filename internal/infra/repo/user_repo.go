@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,17 +23,23 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 // Create salva um novo usuário no banco.
 func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 	query := `
-		INSERT INTO users (id, email, password_hash, display_name, xp, email_verified, created_at, updated_at, last_login_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO users (id, email, password_hash, display_name, xp, email_verified, settings, created_at, updated_at, last_login_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	settings, err := encodeSettings(u.Settings)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query,
 		u.ID,
 		u.Email,
 		u.PasswordHash,
 		u.DisplayName,
 		u.XP,
 		u.EmailVerified,
+		settings,
 		u.CreatedAt,
 		u.UpdatedAt,
 		u.LastLoginAt,
@@ -51,7 +58,7 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 // GetByID busca um usuário pelo ID.
 func (r *UserRepository) GetByID(ctx context.Context, id user.ID) (*user.User, error) {
 	query := `
-		SELECT id, email, password_hash, display_name, xp, email_verified, created_at, updated_at, last_login_at
+		SELECT id, email, password_hash, display_name, xp, email_verified, is_admin, settings, created_at, updated_at, last_login_at
 		FROM users
 		WHERE id = $1
 	`
@@ -62,7 +69,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id user.ID) (*user.User, e
 // GetByEmail busca um usuário pelo email.
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*user.User, error) {
 	query := `
-		SELECT id, email, password_hash, display_name, xp, email_verified, created_at, updated_at, last_login_at
+		SELECT id, email, password_hash, display_name, xp, email_verified, is_admin, settings, created_at, updated_at, last_login_at
 		FROM users
 		WHERE email = $1
 	`
@@ -71,26 +78,32 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*user.Us
 }
 
 // Update atualiza os dados de um usuário existente.
+// O XP não é gravado aqui: ele só muda pela liberação de conquistas (soma atômica).
 func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 	query := `
 		UPDATE users
 		SET email = $2,
 		    password_hash = $3,
 		    display_name = $4,
-		    xp = $5,
-		    email_verified = $6,
+		    email_verified = $5,
+		    settings = $6,
 		    updated_at = $7,
 		    last_login_at = $8
 		WHERE id = $1
 	`
 
+	settings, err := encodeSettings(u.Settings)
+	if err != nil {
+		return err
+	}
+
 	result, err := r.pool.Exec(ctx, query,
 		u.ID,
 		u.Email,
 		u.PasswordHash,
 		u.DisplayName,
-		u.XP,
 		u.EmailVerified,
+		settings,
 		u.UpdatedAt,
 		u.LastLoginAt,
 	)
@@ -120,9 +133,35 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 	return exists, nil
 }
 
+// Search busca usuários por prefixo ou similaridade do nome.
+func (r *UserRepository) Search(ctx context.Context, query string, excludeID user.ID, limit, offset int) ([]*user.User, error) {
+	sql := `
+		SELECT id, email, password_hash, display_name, xp, email_verified, is_admin, settings, created_at, updated_at, last_login_at
+		FROM users
+		WHERE id <> $3
+		  AND COALESCE((settings->>'discoverable')::boolean, TRUE)
+		  AND (display_name ILIKE $2 OR display_name % $1)
+		ORDER BY (display_name ILIKE $2) DESC,
+		         similarity(display_name, $1) DESC,
+		         display_name ASC
+		LIMIT $4 OFFSET $5
+	`
+
+	prefix := escapeLike(query) + "%"
+
+	rows, err := r.pool.Query(ctx, sql, query, prefix, excludeID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanUsers(rows)
+}
+
 // scanUser converte uma linha do banco em um User.
 func (r *UserRepository) scanUser(row pgx.Row) (*user.User, error) {
 	var u user.User
+	var settings []byte
 
 	err := row.Scan(
 		&u.ID,
@@ -131,6 +170,8 @@ func (r *UserRepository) scanUser(row pgx.Row) (*user.User, error) {
 		&u.DisplayName,
 		&u.XP,
 		&u.EmailVerified,
+		&u.IsAdmin,
+		&settings,
 		&u.CreatedAt,
 		&u.UpdatedAt,
 		&u.LastLoginAt,
@@ -143,9 +184,54 @@ func (r *UserRepository) scanUser(row pgx.Row) (*user.User, error) {
 		return nil, err
 	}
 
+	if u.Settings, err = decodeSettings(settings); err != nil {
+		return nil, err
+	}
+
 	return &u, nil
 }
 
+// scanUsers converte múltiplas linhas em uma lista de Users.
+func (r *UserRepository) scanUsers(rows pgx.Rows) ([]*user.User, error) {
+	var users []*user.User
+
+	for rows.Next() {
+		var u user.User
+		var settings []byte
+		err := rows.Scan(
+			&u.ID,
+			&u.Email,
+			&u.PasswordHash,
+			&u.DisplayName,
+			&u.XP,
+			&u.EmailVerified,
+			&u.IsAdmin,
+			&settings,
+			&u.CreatedAt,
+			&u.UpdatedAt,
+			&u.LastLoginAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if u.Settings, err = decodeSettings(settings); err != nil {
+			return nil, err
+		}
+		users = append(users, &u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// escapeLike escapa os curingas do LIKE para que sejam tratados literalmente.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // isDuplicateKeyError verifica se o erro é de chave duplicada.
 func isDuplicateKeyError(err error) bool {
 	// O código de erro do PostgreSQL para unique violation é 23505
@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"encoding/json"
+
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// settingsDocument é o formato JSON das preferências salvas em users.settings.
+type settingsDocument struct {
+	Locale                 string `json:"locale"`
+	DefaultRoomTheme       string `json:"default_room_theme"`
+	ChatSound              bool   `json:"chat_sound"`
+	Autoplay               bool   `json:"autoplay"`
+	AllowDMsFromNonFriends bool   `json:"allow_dms_from_non_friends"`
+	Discoverable           bool   `json:"discoverable"`
+	NotifyAchievements     bool   `json:"notify_achievements"`
+	HideAdultRooms         bool   `json:"hide_adult_rooms"`
+}
+
+// encodeSettings converte as preferências para JSON.
+func encodeSettings(s user.Settings) ([]byte, error) {
+	return json.Marshal(settingsDocument(s))
+}
+
+// decodeSettings converte o JSON salvo em preferências.
+// Campos ausentes (documentos antigos) ficam com o valor padrão.
+func decodeSettings(data []byte) (user.Settings, error) {
+	doc := settingsDocument(user.DefaultSettings())
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return user.Settings{}, err
+		}
+	}
+
+	return user.Settings(doc), nil
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	appachievement "github.com/vinib1903/cineus-api/internal/app/achievement"
+	"github.com/vinib1903/cineus-api/internal/domain/achievement"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// AchievementHandler gerencia as rotas de conquistas (badges).
+type AchievementHandler struct {
+	achievementService *appachievement.Service
+}
+
+// NewAchievementHandler cria uma nova instância do handler.
+func NewAchievementHandler(achievementService *appachievement.Service) *AchievementHandler {
+	return &AchievementHandler{achievementService: achievementService}
+}
+
+// BadgeResponse é a representação de uma conquista na resposta.
+type BadgeResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	XPReward    int64   `json:"xp_reward"`
+	UnlockedAt  *string `json:"unlocked_at,omitempty"`
+}
+
+// toBadgeResponse converte uma Achievement para BadgeResponse.
+func toBadgeResponse(a achievement.Achievement) BadgeResponse {
+	return BadgeResponse{
+		ID:          string(a.ID),
+		Name:        a.Name,
+		Description: a.Description,
+		XPReward:    a.XPReward,
+	}
+}
+
+// Catalog lista todas as conquistas disponíveis.
+// GET /api/v1/badges
+func (h *AchievementHandler) Catalog(w http.ResponseWriter, r *http.Request) {
+	catalog := achievement.Catalog()
+
+	response := make([]BadgeResponse, len(catalog))
+	for i, a := range catalog {
+		response[i] = toBadgeResponse(a)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// ListByUser lista as conquistas liberadas por um usuário.
+// GET /api/v1/users/:id/badges
+func (h *AchievementHandler) ListByUser(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "id")
+	if userID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	unlocked, err := h.achievementService.ListUnlocked(r.Context(), user.ID(userID))
+	if err != nil {
+		if errors.Is(err, appachievement.ErrUserNotFound) {
+			httputil.NotFound(w, "User not found")
+			return
+		}
+		httputil.InternalServerError(w, "Failed to list badges")
+		return
+	}
+
+	response := make([]BadgeResponse, len(unlocked))
+	for i, u := range unlocked {
+		response[i] = toBadgeResponse(u.Achievement)
+		unlockedAt := u.UnlockedAt.Format("2006-01-02T15:04:05Z")
+		response[i].UnlockedAt = &unlockedAt
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
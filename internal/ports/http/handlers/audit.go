@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// AuditEntryResponse é a representação de um registro do log de auditoria.
+type AuditEntryResponse struct {
+	ID        string            `json:"id"`
+	RoomID    string            `json:"room_id"`
+	Action    string            `json:"action"`
+	ActorID   string            `json:"actor_id"`
+	TargetID  string            `json:"target_id,omitempty"`
+	Reason    string            `json:"reason,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt string            `json:"created_at"`
+}
+
+// AuditLogResponse é uma página do log de auditoria.
+type AuditLogResponse struct {
+	Entries    []AuditEntryResponse `json:"entries"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
+// toAuditLogResponse converte uma página do log para AuditLogResponse.
+func toAuditLogResponse(output *approom.ListAuditOutput) AuditLogResponse {
+	response := AuditLogResponse{
+		Entries:    make([]AuditEntryResponse, len(output.Entries)),
+		NextCursor: output.NextCursor,
+	}
+
+	for i, e := range output.Entries {
+		response.Entries[i] = AuditEntryResponse{
+			ID:        string(e.ID),
+			RoomID:    string(e.RoomID),
+			Action:    string(e.Action),
+			ActorID:   string(e.ActorID),
+			TargetID:  string(e.TargetID),
+			Reason:    e.Reason,
+			Details:   e.Details,
+			CreatedAt: e.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	return response
+}
+
+// auditInputFromQuery lê os filtros do log de auditoria da query string.
+func auditInputFromQuery(query url.Values) approom.ListAuditInput {
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	return approom.ListAuditInput{
+		Action:   room.AuditAction(query.Get("action")),
+		ActorID:  user.ID(query.Get("actor_id")),
+		TargetID: user.ID(query.Get("target_id")),
+		Cursor:   query.Get("cursor"),
+		Limit:    limit,
+	}
+}
+
+// ListAudit retorna o log de auditoria da moderação da sala.
+// GET /api/v1/rooms/:id/audit?action=&actor_id=&target_id=&cursor=&limit=
+func (h *RoomHandler) ListAudit(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	input := auditInputFromQuery(r.URL.Query())
+	input.RoomID = room.ID(roomID)
+	input.RequesterID = user.ID(userID)
+
+	output, err := h.roomService.ListAudit(r.Context(), input)
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toAuditLogResponse(output))
+}
+
+// ListAllAudit retorna o log de auditoria de todas as salas (admins).
+// GET /api/v1/admin/audit?room_id=&action=&actor_id=&target_id=&cursor=&limit=
+func (h *RoomHandler) ListAllAudit(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	input := auditInputFromQuery(query)
+	input.RoomID = room.ID(query.Get("room_id"))
+	input.RequesterID = user.ID(httputil.GetUserID(r.Context()))
+
+	output, err := h.roomService.ListAllAudit(r.Context(), input)
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toAuditLogResponse(output))
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// BanResponse é a representação de um banimento na resposta.
+type BanResponse struct {
+	ID        string  `json:"id"`
+	RoomID    string  `json:"room_id"`
+	UserID    string  `json:"user_id"`
+	BannedBy  string  `json:"banned_by"`
+	Reason    string  `json:"reason,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"` // Omitido = permanente
+	CreatedAt string  `json:"created_at"`
+}
+
+// toBanResponse converte um Ban para BanResponse.
+func toBanResponse(b *room.Ban) BanResponse {
+	resp := BanResponse{
+		ID:        string(b.ID),
+		RoomID:    string(b.RoomID),
+		UserID:    string(b.UserID),
+		BannedBy:  string(b.BannedBy),
+		Reason:    b.Reason,
+		CreatedAt: b.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if b.ExpiresAt != nil {
+		expiresAt := b.ExpiresAt.Format("2006-01-02T15:04:05Z")
+		resp.ExpiresAt = &expiresAt
+	}
+
+	return resp
+}
+
+// BanRequest é o corpo da requisição de banimento.
+type BanRequest struct {
+	UserID    string     `json:"user_id"`
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at"` // RFC 3339; omitido = permanente
+}
+
+// Ban bane um usuário da sala.
+// POST /api/v1/rooms/:id/bans
+func (h *RoomHandler) Ban(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req BanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.UserID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	ban, err := h.roomService.Ban(r.Context(), approom.BanInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		UserID:      user.ID(req.UserID),
+		Reason:      req.Reason,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toBanResponse(ban))
+}
+
+// ListBans lista os bans ativos da sala.
+// GET /api/v1/rooms/:id/bans
+func (h *RoomHandler) ListBans(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	bans, err := h.roomService.ListBans(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]BanResponse, len(bans))
+	for i, b := range bans {
+		response[i] = toBanResponse(b)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// Unban remove o ban de um usuário da sala.
+// DELETE /api/v1/rooms/:id/bans/:userId
+func (h *RoomHandler) Unban(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	targetID := chi.URLParam(r, "userId")
+	if roomID == "" || targetID == "" {
+		httputil.BadRequest(w, "Room ID and user ID are required")
+		return
+	}
+
+	err := h.roomService.Unban(r.Context(), approom.UnbanInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		UserID:      user.ID(targetID),
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "User unbanned successfully"})
+}
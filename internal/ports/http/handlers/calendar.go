@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// Constantes do export iCalendar.
+const (
+	icsTimeFormat = "20060102T150405Z"
+
+	// icsDefaultDuration é a duração usada no calendário (a sessão não tem fim definido).
+	icsDefaultDuration = 2 * time.Hour
+
+	// icsMaxLineLength é o tamanho máximo de uma linha (RFC 5545).
+	icsMaxLineLength = 75
+)
+
+// icsEscaper escapa texto nos campos do iCalendar.
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// writeCalendar responde com as sessões no formato iCalendar (.ics).
+func writeCalendar(w http.ResponseWriter, filename string, rm *room.Room, events []*room.Event) {
+	var b strings.Builder
+	now := time.Now().UTC().Format(icsTimeFormat)
+
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//CineUs//Watch Parties//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+
+	for _, e := range events {
+		description := e.Description
+		if e.MediaURL != "" {
+			if description != "" {
+				description += "\n\n"
+			}
+			description += "Watching: " + e.MediaTitle + " " + e.MediaURL
+		}
+
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, "UID:"+string(e.ID)+"@cineus")
+		writeICSLine(&b, "DTSTAMP:"+now)
+		writeICSLine(&b, "DTSTART:"+e.StartsAt.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "DTEND:"+e.StartsAt.Add(icsDefaultDuration).UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "SUMMARY:"+icsEscaper.Replace(e.Title))
+		if description != "" {
+			writeICSLine(&b, "DESCRIPTION:"+icsEscaper.Replace(description))
+		}
+		writeICSLine(&b, "LOCATION:"+icsEscaper.Replace(rm.Name))
+		writeICSLine(&b, "LAST-MODIFIED:"+e.UpdatedAt.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(b.String()))
+}
+
+// writeICSLine escreve uma linha terminada em CRLF, quebrando linhas longas
+// sem partir caracteres UTF-8 (continuações começam com espaço).
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsMaxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsMaxLineLength - 1 // O espaço da continuação conta
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	appchat "github.com/vinib1903/cineus-api/internal/app/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// ChatHandler gerencia as rotas do chat.
+type ChatHandler struct {
+	chatService *appchat.Service
+}
+
+// NewChatHandler cria uma nova instância do handler.
+func NewChatHandler(chatService *appchat.Service) *ChatHandler {
+	return &ChatHandler{chatService: chatService}
+}
+
+// MessageResponse é a representação de uma mensagem de sala na resposta.
+type MessageResponse struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+}
+
+// MessageHistoryResponse é uma página do histórico do chat.
+type MessageHistoryResponse struct {
+	Messages []MessageResponse `json:"messages"` // Das mais antigas para as mais recentes
+	// Valor de before para buscar a página anterior (vazio quando não há mais)
+	NextBefore string `json:"next_before,omitempty"`
+}
+
+// toMessageResponse converte uma Message para MessageResponse.
+func toMessageResponse(m *chat.Message) MessageResponse {
+	return MessageResponse{
+		ID:        string(m.ID),
+		UserID:    string(m.UserID),
+		Content:   m.Content,
+		CreatedAt: m.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListRoomMessages retorna o histórico do chat da sala.
+// GET /api/v1/rooms/:id/messages?before=&limit=
+func (h *ChatHandler) ListRoomMessages(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	input := appchat.ListRoomMessagesInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		Before:      query.Get("before"),
+		Limit:       limit,
+	}
+
+	output, err := h.chatService.ListRoomMessages(r.Context(), input)
+	if err != nil {
+		handleChatError(w, err)
+		return
+	}
+
+	response := MessageHistoryResponse{
+		Messages: make([]MessageResponse, len(output.Messages)),
+	}
+	for i, m := range output.Messages {
+		response.Messages[i] = toMessageResponse(m)
+	}
+	response.NextBefore = output.NextBefore
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// DirectMessageResponse é a representação de uma mensagem direta na resposta.
+type DirectMessageResponse struct {
+	ID         string  `json:"id"`
+	FromUserID string  `json:"from_user_id"`
+	ToUserID   string  `json:"to_user_id"`
+	Content    string  `json:"content"`
+	ReadAt     *string `json:"read_at,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// ConversationResponse é uma página da conversa entre dois usuários.
+type ConversationResponse struct {
+	Messages   []DirectMessageResponse `json:"messages"` // Das mais antigas para as mais recentes
+	NextBefore string                  `json:"next_before,omitempty"`
+}
+
+// toDirectMessageResponse converte uma DirectMessage para DirectMessageResponse.
+func toDirectMessageResponse(dm *chat.DirectMessage) DirectMessageResponse {
+	resp := DirectMessageResponse{
+		ID:         string(dm.ID),
+		FromUserID: string(dm.FromUserID),
+		ToUserID:   string(dm.ToUserID),
+		Content:    dm.Content,
+		CreatedAt:  dm.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if dm.ReadAt != nil {
+		readAt := dm.ReadAt.Format("2006-01-02T15:04:05Z")
+		resp.ReadAt = &readAt
+	}
+
+	return resp
+}
+
+// SendDirectMessageRequest é o corpo da requisição de envio de mensagem direta.
+type SendDirectMessageRequest struct {
+	Content string `json:"content"`
+}
+
+// SendDirectMessage envia uma mensagem direta para outro usuário.
+// POST /api/v1/me/conversations/:userId/messages
+func (h *ChatHandler) SendDirectMessage(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	otherUserID := chi.URLParam(r, "userId")
+	if otherUserID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	var req SendDirectMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	dm, err := h.chatService.SendDirectMessage(r.Context(), appchat.SendDirectMessageInput{
+		FromUserID: user.ID(userID),
+		ToUserID:   user.ID(otherUserID),
+		Content:    req.Content,
+	})
+	if err != nil {
+		handleChatError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toDirectMessageResponse(dm))
+}
+
+// ListConversation retorna a conversa com outro usuário.
+// GET /api/v1/me/conversations/:userId/messages?before=&limit=
+func (h *ChatHandler) ListConversation(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	otherUserID := chi.URLParam(r, "userId")
+	if otherUserID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	input := appchat.ListConversationInput{
+		UserID:      user.ID(userID),
+		OtherUserID: user.ID(otherUserID),
+		Before:      query.Get("before"),
+		Limit:       limit,
+	}
+
+	output, err := h.chatService.ListConversation(r.Context(), input)
+	if err != nil {
+		handleChatError(w, err)
+		return
+	}
+
+	response := ConversationResponse{
+		Messages: make([]DirectMessageResponse, len(output.Messages)),
+	}
+	for i, dm := range output.Messages {
+		response.Messages[i] = toDirectMessageResponse(dm)
+	}
+	response.NextBefore = output.NextBefore
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// MarkConversationRead marca como lidas as mensagens recebidas de outro usuário.
+// POST /api/v1/me/conversations/:userId/read
+func (h *ChatHandler) MarkConversationRead(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	otherUserID := chi.URLParam(r, "userId")
+	if otherUserID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	if err := h.chatService.MarkConversationRead(r.Context(), user.ID(userID), user.ID(otherUserID)); err != nil {
+		handleChatError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnreadResponse é a quantidade de mensagens diretas não lidas.
+type UnreadResponse struct {
+	Unread int `json:"unread"`
+}
+
+// CountUnread retorna quantas mensagens diretas o usuário ainda não leu.
+// GET /api/v1/me/messages/unread
+func (h *ChatHandler) CountUnread(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	count, err := h.chatService.CountUnread(r.Context(), user.ID(userID))
+	if err != nil {
+		handleChatError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, UnreadResponse{Unread: count})
+}
+
+// handleChatError mapeia erros do chat para respostas HTTP.
+func handleChatError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, appchat.ErrRoomNotFound):
+		httputil.NotFound(w, "Room not found")
+	case errors.Is(err, appchat.ErrForbidden):
+		httputil.Forbidden(w, "You cannot read the messages of this room")
+	case errors.Is(err, appchat.ErrInvalidCursor):
+		httputil.BadRequest(w, "Invalid before cursor")
+	case errors.Is(err, appchat.ErrRecipientNotFound):
+		httputil.NotFound(w, "User not found")
+	case errors.Is(err, appchat.ErrCannotMessageSelf):
+		httputil.BadRequest(w, "You cannot send a message to yourself")
+	case errors.Is(err, appchat.ErrDirectMessagesOff):
+		httputil.Forbidden(w, "This user does not accept direct messages")
+	case errors.Is(err, chat.ErrMessageEmpty):
+		httputil.BadRequest(w, "Message content cannot be empty")
+	case errors.Is(err, chat.ErrMessageTooLong):
+		httputil.BadRequest(w, "Message cannot exceed 500 characters")
+	default:
+		httputil.InternalServerError(w, "An unexpected error occurred")
+	}
+}
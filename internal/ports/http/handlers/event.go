@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// EventResponse é a representação de uma sessão agendada na resposta.
+type EventResponse struct {
+	ID          string  `json:"id"`
+	RoomID      string  `json:"room_id"`
+	CreatedBy   string  `json:"created_by"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	StartsAt    string  `json:"starts_at"`
+	MediaURL    string  `json:"media_url,omitempty"`
+	MediaTitle  string  `json:"media_title,omitempty"`
+	StartedAt   *string `json:"started_at,omitempty"`
+}
+
+// toEventResponse converte um Event para EventResponse.
+func toEventResponse(e *room.Event) EventResponse {
+	resp := EventResponse{
+		ID:          string(e.ID),
+		RoomID:      string(e.RoomID),
+		CreatedBy:   string(e.CreatedBy),
+		Title:       e.Title,
+		Description: e.Description,
+		StartsAt:    e.StartsAt.Format("2006-01-02T15:04:05Z"),
+		MediaURL:    e.MediaURL,
+		MediaTitle:  e.MediaTitle,
+	}
+
+	if e.StartedAt != nil {
+		startedAt := e.StartedAt.Format("2006-01-02T15:04:05Z")
+		resp.StartedAt = &startedAt
+	}
+
+	return resp
+}
+
+// RSVPResponse é a representação de uma resposta a uma sessão.
+type RSVPResponse struct {
+	UserID    string `json:"user_id"`
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// toRSVPResponse converte um RSVP para RSVPResponse.
+func toRSVPResponse(r *room.RSVP) RSVPResponse {
+	return RSVPResponse{
+		UserID:    string(r.UserID),
+		Status:    string(r.Status),
+		UpdatedAt: r.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// CreateEventRequest é o corpo da requisição de agendamento.
+type CreateEventRequest struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	StartsAt    time.Time `json:"starts_at"` // RFC 3339
+	MediaURL    string    `json:"media_url"`
+	MediaTitle  string    `json:"media_title"`
+}
+
+// CreateEvent agenda uma sessão na sala.
+// POST /api/v1/rooms/:id/events
+func (h *RoomHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req CreateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.Title == "" || req.StartsAt.IsZero() {
+		httputil.BadRequest(w, "Title and starts_at are required")
+		return
+	}
+
+	event, err := h.roomService.CreateEvent(r.Context(), approom.CreateEventInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		Title:       req.Title,
+		Description: req.Description,
+		StartsAt:    req.StartsAt,
+		MediaURL:    req.MediaURL,
+		MediaTitle:  req.MediaTitle,
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toEventResponse(event))
+}
+
+// ListEvents lista as próximas sessões da sala.
+// GET /api/v1/rooms/:id/events
+func (h *RoomHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	events, err := h.roomService.ListEvents(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]EventResponse, len(events))
+	for i, e := range events {
+		response[i] = toEventResponse(e)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// GetEvent retorna uma sessão da sala.
+// GET /api/v1/rooms/:id/events/:eventId
+func (h *RoomHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	event, err := h.roomService.GetEvent(r.Context(), room.ID(roomID), room.EventID(eventID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toEventResponse(event))
+}
+
+// UpdateEventRequest é o corpo da requisição de edição de sessão.
+// Campos ausentes não são alterados.
+type UpdateEventRequest struct {
+	Title       *string    `json:"title"`
+	Description *string    `json:"description"`
+	StartsAt    *time.Time `json:"starts_at"`
+	MediaURL    *string    `json:"media_url"`
+	MediaTitle  *string    `json:"media_title"`
+}
+
+// UpdateEvent edita uma sessão que ainda não começou.
+// PATCH /api/v1/rooms/:id/events/:eventId
+func (h *RoomHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	var req UpdateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	event, err := h.roomService.UpdateEvent(r.Context(), approom.UpdateEventInput{
+		RoomID:      room.ID(roomID),
+		EventID:     room.EventID(eventID),
+		RequesterID: user.ID(userID),
+		Title:       req.Title,
+		Description: req.Description,
+		StartsAt:    req.StartsAt,
+		MediaURL:    req.MediaURL,
+		MediaTitle:  req.MediaTitle,
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toEventResponse(event))
+}
+
+// DeleteEvent remove uma sessão agendada.
+// DELETE /api/v1/rooms/:id/events/:eventId
+func (h *RoomHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	err := h.roomService.DeleteEvent(r.Context(), room.ID(roomID), room.EventID(eventID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "Event deleted successfully"})
+}
+
+// RSVPRequest é o corpo da requisição de resposta a uma sessão.
+type RSVPRequest struct {
+	Status string `json:"status"` // going, maybe ou declined
+}
+
+// RSVP registra a resposta do usuário a uma sessão.
+// PUT /api/v1/rooms/:id/events/:eventId/rsvp
+func (h *RoomHandler) RSVP(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	var req RSVPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	rsvp, err := h.roomService.RSVP(r.Context(), approom.RSVPInput{
+		RoomID:  room.ID(roomID),
+		EventID: room.EventID(eventID),
+		UserID:  user.ID(userID),
+		Status:  room.RSVPStatus(req.Status),
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRSVPResponse(rsvp))
+}
+
+// CancelRSVP remove a resposta do usuário a uma sessão.
+// DELETE /api/v1/rooms/:id/events/:eventId/rsvp
+func (h *RoomHandler) CancelRSVP(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	err := h.roomService.CancelRSVP(r.Context(), room.ID(roomID), room.EventID(eventID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "RSVP removed"})
+}
+
+// ListRSVPs lista as respostas a uma sessão.
+// GET /api/v1/rooms/:id/events/:eventId/rsvps
+func (h *RoomHandler) ListRSVPs(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	rsvps, err := h.roomService.ListRSVPs(r.Context(), room.ID(roomID), room.EventID(eventID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]RSVPResponse, len(rsvps))
+	for i, rsvp := range rsvps {
+		response[i] = toRSVPResponse(rsvp)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// ExportEvent exporta uma sessão como arquivo de calendário.
+// GET /api/v1/rooms/:id/events/:eventId/calendar.ics
+func (h *RoomHandler) ExportEvent(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	eventID := chi.URLParam(r, "eventId")
+	if roomID == "" || eventID == "" {
+		httputil.BadRequest(w, "Room ID and event ID are required")
+		return
+	}
+
+	rm, err := h.roomService.GetByID(r.Context(), room.ID(roomID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	event, err := h.roomService.GetEvent(r.Context(), rm.ID, room.EventID(eventID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	writeCalendar(w, "event-"+eventID+".ics", rm, []*room.Event{event})
+}
+
+// ExportEvents exporta as próximas sessões da sala como calendário.
+// GET /api/v1/rooms/:id/events/calendar.ics
+func (h *RoomHandler) ExportEvents(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	rm, err := h.roomService.GetByID(r.Context(), room.ID(roomID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	events, err := h.roomService.ListEvents(r.Context(), rm.ID, user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	writeCalendar(w, "room-"+roomID+".ics", rm, events)
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// InviteResponse é a representação de um convite na resposta.
+type InviteResponse struct {
+	ID        string  `json:"id"`
+	RoomID    string  `json:"room_id"`
+	Token     string  `json:"token"`
+	CreatedBy string  `json:"created_by"`
+	MaxUses   int     `json:"max_uses"` // 0 = ilimitado
+	Uses      int     `json:"uses"`
+	SeatID    string  `json:"seat_id,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	RevokedAt *string `json:"revoked_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// toInviteResponse converte um Invite para InviteResponse.
+func toInviteResponse(i *room.Invite) InviteResponse {
+	resp := InviteResponse{
+		ID:        string(i.ID),
+		RoomID:    string(i.RoomID),
+		Token:     i.Token,
+		CreatedBy: string(i.CreatedBy),
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		SeatID:    i.SeatID,
+		CreatedAt: i.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if i.ExpiresAt != nil {
+		expiresAt := i.ExpiresAt.Format("2006-01-02T15:04:05Z")
+		resp.ExpiresAt = &expiresAt
+	}
+	if i.RevokedAt != nil {
+		revokedAt := i.RevokedAt.Format("2006-01-02T15:04:05Z")
+		resp.RevokedAt = &revokedAt
+	}
+
+	return resp
+}
+
+// CreateInviteRequest é o corpo da requisição de criação de convite.
+type CreateInviteRequest struct {
+	MaxUses   int        `json:"max_uses"`   // Opcional (0 = ilimitado)
+	ExpiresAt *time.Time `json:"expires_at"` // Opcional (RFC 3339)
+	SeatID    string     `json:"seat_id"`    // Opcional
+}
+
+// CreateInvite cria um link de convite para a sala.
+// POST /api/v1/rooms/:id/invites
+func (h *RoomHandler) CreateInvite(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req CreateInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	invite, err := h.roomService.CreateInvite(r.Context(), approom.CreateInviteInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		MaxUses:     req.MaxUses,
+		ExpiresAt:   req.ExpiresAt,
+		SeatID:      req.SeatID,
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toInviteResponse(invite))
+}
+
+// ListInvites lista os convites da sala.
+// GET /api/v1/rooms/:id/invites
+func (h *RoomHandler) ListInvites(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	invites, err := h.roomService.ListInvites(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]InviteResponse, len(invites))
+	for i, invite := range invites {
+		response[i] = toInviteResponse(invite)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// RevokeInvite invalida um convite da sala.
+// DELETE /api/v1/rooms/:id/invites/:inviteId
+func (h *RoomHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	inviteID := chi.URLParam(r, "inviteId")
+	if roomID == "" || inviteID == "" {
+		httputil.BadRequest(w, "Room ID and invite ID are required")
+		return
+	}
+
+	err := h.roomService.RevokeInvite(r.Context(), room.ID(roomID), room.InviteID(inviteID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "Invite revoked"})
+}
+
+// AcceptInvite aceita um convite e torna o usuário membro da sala.
+// POST /api/v1/invites/:token/accept
+func (h *RoomHandler) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		httputil.BadRequest(w, "Invite token is required")
+		return
+	}
+
+	rm, err := h.roomService.AcceptInvite(r.Context(), token, user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	// Retorna a sala (sem o código, pois não precisa mais dele)
+	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, rm.IsOwner(user.ID(userID))))
+}
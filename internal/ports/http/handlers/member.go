@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// MemberResponse é a representação de um membro da sala na resposta.
+type MemberResponse struct {
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	SeatID    string `json:"seat_id,omitempty"` // Assento reservado
+	UpdatedAt string `json:"updated_at"`
+}
+
+// toMemberResponse converte um Member para MemberResponse.
+func toMemberResponse(m *room.Member) MemberResponse {
+	return MemberResponse{
+		UserID:    string(m.UserID),
+		Role:      string(m.Role),
+		SeatID:    m.SeatID,
+		UpdatedAt: m.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListMembers lista o dono e os membros com papel da sala.
+// GET /api/v1/rooms/:id/members
+func (h *RoomHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	members, err := h.roomService.ListMembers(r.Context(), room.ID(roomID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]MemberResponse, len(members))
+	for i, m := range members {
+		response[i] = toMemberResponse(m)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// SetRoleRequest é o corpo da requisição de atribuição de papel.
+type SetRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// SetRole atribui um papel a um usuário da sala (apenas o dono).
+// PUT /api/v1/rooms/:id/members/:userId/role
+func (h *RoomHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	targetID := chi.URLParam(r, "userId")
+	if roomID == "" || targetID == "" {
+		httputil.BadRequest(w, "Room ID and user ID are required")
+		return
+	}
+
+	var req SetRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.Role == "" {
+		httputil.BadRequest(w, "Role is required")
+		return
+	}
+
+	member, err := h.roomService.SetRole(r.Context(), approom.SetRoleInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		UserID:      user.ID(targetID),
+		Role:        room.Role(req.Role),
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toMemberResponse(member))
+}
+
+// AssignSeatRequest é o corpo da requisição de reserva de assento.
+type AssignSeatRequest struct {
+	SeatID string `json:"seat_id"`
+}
+
+// AssignSeat reserva um assento para um usuário da sala.
+// PUT /api/v1/rooms/:id/members/:userId/seat
+func (h *RoomHandler) AssignSeat(w http.ResponseWriter, r *http.Request) {
+	var req AssignSeatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.SeatID == "" {
+		httputil.BadRequest(w, "Seat ID is required")
+		return
+	}
+
+	h.assignSeat(w, r, req.SeatID)
+}
+
+// ClearSeat remove a reserva de assento de um usuário da sala.
+// DELETE /api/v1/rooms/:id/members/:userId/seat
+func (h *RoomHandler) ClearSeat(w http.ResponseWriter, r *http.Request) {
+	h.assignSeat(w, r, "")
+}
+
+// assignSeat aplica a reserva (ou a remoção) e responde com o membro.
+func (h *RoomHandler) assignSeat(w http.ResponseWriter, r *http.Request, seatID string) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	targetID := chi.URLParam(r, "userId")
+	if roomID == "" || targetID == "" {
+		httputil.BadRequest(w, "Room ID and user ID are required")
+		return
+	}
+
+	member, err := h.roomService.AssignSeat(r.Context(), approom.AssignSeatInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		UserID:      user.ID(targetID),
+		SeatID:      seatID,
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toMemberResponse(member))
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// DeletedRoomResponse é uma sala deletada que ainda pode ser restaurada.
+type DeletedRoomResponse struct {
+	RoomResponse
+	DeletedAt       string `json:"deleted_at"`
+	RestoreDeadline string `json:"restore_deadline"`
+}
+
+// PurgeResultResponse conta o que foi apagado pela limpeza.
+type PurgeResultResponse struct {
+	Rooms        int64 `json:"rooms"`
+	ChatMessages int64 `json:"chat_messages"`
+	Bans         int64 `json:"bans"`
+	Invites      int64 `json:"invites"`
+	Members      int64 `json:"members"`
+	Events       int64 `json:"events"`
+}
+
+// PurgeStatsResponse são as métricas da limpeza de salas deletadas.
+type PurgeStatsResponse struct {
+	Runs      int64               `json:"runs"`
+	Failures  int64               `json:"failures"`
+	LastRunAt *string             `json:"last_run_at"`
+	LastRun   PurgeResultResponse `json:"last_run"`
+	Removed   PurgeResultResponse `json:"removed"`
+}
+
+// toPurgeResultResponse converte um PurgeResult para PurgeResultResponse.
+func toPurgeResultResponse(p room.PurgeResult) PurgeResultResponse {
+	return PurgeResultResponse{
+		Rooms:        p.Rooms,
+		ChatMessages: p.ChatMessages,
+		Bans:         p.Bans,
+		Invites:      p.Invites,
+		Members:      p.Members,
+		Events:       p.Events,
+	}
+}
+
+// ListDeleted lista as salas do usuário que ainda podem ser restauradas.
+// GET /api/v1/rooms/my/deleted
+func (h *RoomHandler) ListDeleted(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	rooms, err := h.roomService.ListDeletedRooms(r.Context(), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	response := make([]DeletedRoomResponse, len(rooms))
+	for i, rm := range rooms {
+		response[i] = DeletedRoomResponse{
+			RoomResponse:    toRoomResponse(rm, true),
+			DeletedAt:       rm.DeletedAt.Format("2006-01-02T15:04:05Z"),
+			RestoreDeadline: h.roomService.RestoreDeadline(rm).Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// Restore desfaz a deleção de uma sala (apenas o dono, dentro do prazo).
+// POST /api/v1/rooms/:id/restore
+func (h *RoomHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	rm, err := h.roomService.RestoreRoom(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, true))
+}
+
+// PurgeStats retorna as métricas da limpeza de salas deletadas.
+// GET /api/v1/stats/purge
+func (h *RoomHandler) PurgeStats(w http.ResponseWriter, r *http.Request) {
+	stats := h.roomService.PurgeStats()
+
+	response := PurgeStatsResponse{
+		Runs:     stats.Runs,
+		Failures: stats.Failures,
+		LastRun:  toPurgeResultResponse(stats.LastRun),
+		Removed:  toPurgeResultResponse(stats.Removed),
+	}
+	if stats.LastRunAt != nil {
+		lastRunAt := stats.LastRunAt.Format("2006-01-02T15:04:05Z")
+		response.LastRunAt = &lastRunAt
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
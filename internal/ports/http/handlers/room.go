@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	approom "github.com/vinib1903/cineus-api/internal/app/room"
@@ -31,19 +33,32 @@ type RoomResponse struct {
 	Visibility string  `json:"visibility"`
 	AccessCode *string `json:"access_code,omitempty"` // Só retorna para o dono
 	MaxSeats   int     `json:"max_seats"`
-	CreatedAt  string  `json:"created_at"`
+
+	Tags          []string `json:"tags"`
+	Category      string   `json:"category,omitempty"`
+	Language      string   `json:"language,omitempty"`
+	ContentRating string   `json:"content_rating"`
+
+	CreatedAt string `json:"created_at"`
 }
 
 // toRoomResponse converte uma Room para RoomResponse.
 func toRoomResponse(r *room.Room, includeCode bool) RoomResponse {
 	resp := RoomResponse{
-		ID:         string(r.ID),
-		OwnerID:    string(r.OwnerID),
-		Name:       r.Name,
-		Theme:      string(r.Theme),
-		Visibility: string(r.Visibility),
-		MaxSeats:   r.MaxSeats,
-		CreatedAt:  r.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:            string(r.ID),
+		OwnerID:       string(r.OwnerID),
+		Name:          r.Name,
+		Theme:         string(r.Theme),
+		Visibility:    string(r.Visibility),
+		MaxSeats:      r.MaxSeats,
+		Tags:          r.Tags,
+		Category:      string(r.Category),
+		Language:      r.Language,
+		ContentRating: string(r.ContentRating),
+		CreatedAt:     r.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
 	}
 
 	// Só inclui o código se for o dono
@@ -56,9 +71,13 @@ func toRoomResponse(r *room.Room, includeCode bool) RoomResponse {
 
 // CreateRequest é o corpo da requisição de criação de sala.
 type CreateRequest struct {
-	Name       string `json:"name"`
-	Theme      string `json:"theme"`
-	Visibility string `json:"visibility"`
+	Name          string   `json:"name"`
+	Theme         string   `json:"theme"`
+	Visibility    string   `json:"visibility"`
+	Tags          []string `json:"tags"`
+	Category      string   `json:"category"`
+	Language      string   `json:"language"`
+	ContentRating string   `json:"content_rating"` // all (padrão), 12+, 16+ ou 18+
 }
 
 // Create cria uma nova sala.
@@ -84,11 +103,8 @@ func (h *RoomHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Converter theme (usar default se não fornecido)
+	// Converter theme (vazio = tema padrão das preferências do usuário)
 	theme := room.Theme(req.Theme)
-	if theme == "" {
-		theme = room.ThemeDefault
-	}
 
 	// Converter visibility (usar public se não fornecido)
 	visibility := room.Visibility(req.Visibility)
@@ -102,6 +118,12 @@ func (h *RoomHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Name:       req.Name,
 		Theme:      theme,
 		Visibility: visibility,
+		Classification: room.Classification{
+			Tags:          req.Tags,
+			Category:      room.Category(req.Category),
+			Language:      req.Language,
+			ContentRating: room.ContentRating(req.ContentRating),
+		},
 	})
 
 	if err != nil {
@@ -113,24 +135,73 @@ func (h *RoomHandler) Create(w http.ResponseWriter, r *http.Request) {
 	httputil.JSON(w, http.StatusCreated, toRoomResponse(output.Room, true))
 }
 
+// PublicRoomResponse é uma sala da listagem pública com seu estado ao vivo.
+type PublicRoomResponse struct {
+	RoomResponse
+	Viewers    int                 `json:"viewers"`
+	NowPlaying *NowPlayingResponse `json:"now_playing,omitempty"`
+}
+
+// NowPlayingResponse é o vídeo carregado em uma sala ativa.
+type NowPlayingResponse struct {
+	VideoURL   string `json:"video_url"`
+	VideoTitle string `json:"video_title"`
+	IsPlaying  bool   `json:"is_playing"`
+}
+
+// ListPublicResponse é uma página da listagem pública.
+type ListPublicResponse struct {
+	Rooms      []PublicRoomResponse `json:"rooms"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
 // ListPublic lista as salas públicas.
-// GET /api/v1/rooms
+// Com usuário autenticado, respeita a preferência de esconder salas 18+.
+// GET /api/v1/rooms?limit=&cursor=&theme=&q=&sort=newest|viewers&tags=a,b&category=&language=&rating=
 func (h *RoomHandler) ListPublic(w http.ResponseWriter, r *http.Request) {
-	// TODO: Pegar limit e offset da query string
-	rooms, err := h.roomService.ListPublic(r.Context(), approom.ListPublicInput{
-		Limit:  20,
-		Offset: 0,
-	})
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	var tags []string
+	if raw := query.Get("tags"); raw != "" {
+		tags = strings.Split(raw, ",")
+	}
 
+	output, err := h.roomService.ListPublic(r.Context(), approom.ListPublicInput{
+		Limit:    limit,
+		Cursor:   query.Get("cursor"),
+		Theme:    room.Theme(query.Get("theme")),
+		Query:    query.Get("q"),
+		Sort:     query.Get("sort"),
+		Tags:     tags,
+		Category: room.Category(query.Get("category")),
+		Language: query.Get("language"),
+		Rating:   room.ContentRating(query.Get("rating")),
+		ViewerID: user.ID(httputil.GetUserID(r.Context())),
+	})
 	if err != nil {
-		httputil.InternalServerError(w, "Failed to list rooms")
+		handleRoomError(w, err)
 		return
 	}
 
 	// Converter para response (sem código de acesso)
-	response := make([]RoomResponse, len(rooms))
-	for i, rm := range rooms {
-		response[i] = toRoomResponse(rm, false)
+	response := ListPublicResponse{
+		Rooms:      make([]PublicRoomResponse, len(output.Rooms)),
+		NextCursor: output.NextCursor,
+	}
+	for i, pr := range output.Rooms {
+		item := PublicRoomResponse{
+			RoomResponse: toRoomResponse(pr.Room, false),
+			Viewers:      pr.Viewers,
+		}
+		if pr.NowPlaying != nil {
+			item.NowPlaying = &NowPlayingResponse{
+				VideoURL:   pr.NowPlaying.VideoURL,
+				VideoTitle: pr.NowPlaying.VideoTitle,
+				IsPlaying:  pr.NowPlaying.IsPlaying,
+			}
+		}
+		response.Rooms[i] = item
 	}
 
 	httputil.JSON(w, http.StatusOK, response)
@@ -182,6 +253,97 @@ func (h *RoomHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, isOwner))
 }
 
+// UpdateRequest é o corpo da requisição de alteração de sala.
+// Campos omitidos mantêm o valor atual.
+type UpdateRequest struct {
+	Name          *string   `json:"name"`
+	Theme         *string   `json:"theme"`
+	Tags          *[]string `json:"tags"`
+	Category      *string   `json:"category"` // "" remove a categoria
+	Language      *string   `json:"language"` // "" remove o idioma
+	ContentRating *string   `json:"content_rating"`
+}
+
+// Update altera o nome, o tema e/ou a classificação de uma sala.
+// PATCH /api/v1/rooms/:id
+func (h *RoomHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.Name == nil && req.Theme == nil && req.Tags == nil &&
+		req.Category == nil && req.Language == nil && req.ContentRating == nil {
+		httputil.BadRequest(w, "Nothing to update")
+		return
+	}
+
+	input := approom.UpdateInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		Name:        req.Name,
+		Tags:        req.Tags,
+		Language:    req.Language,
+	}
+	if req.Theme != nil {
+		theme := room.Theme(*req.Theme)
+		input.Theme = &theme
+	}
+	if req.Category != nil {
+		category := room.Category(*req.Category)
+		input.Category = &category
+	}
+	if req.ContentRating != nil {
+		rating := room.ContentRating(*req.ContentRating)
+		input.ContentRating = &rating
+	}
+
+	rm, err := h.roomService.Update(r.Context(), input)
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, true))
+}
+
+// RegenerateAccessCode gera um novo código de acesso para uma sala privada.
+// POST /api/v1/rooms/:id/access-code
+func (h *RoomHandler) RegenerateAccessCode(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	rm, err := h.roomService.RegenerateAccessCode(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, true))
+}
+
 // JoinByCodeRequest é o corpo da requisição de entrar por código.
 type JoinByCodeRequest struct {
 	AccessCode string `json:"access_code"`
@@ -210,6 +372,7 @@ func (h *RoomHandler) JoinByCode(w http.ResponseWriter, r *http.Request) {
 	rm, err := h.roomService.JoinByCode(r.Context(), approom.JoinByCodeInput{
 		AccessCode: req.AccessCode,
 		UserID:     user.ID(userID),
+		ClientIP:   httputil.ClientIP(r),
 	})
 
 	if err != nil {
@@ -221,6 +384,7 @@ func (h *RoomHandler) JoinByCode(w http.ResponseWriter, r *http.Request) {
 }
 
 // Delete deleta uma sala.
+// Com ?force=true, desconecta quem estiver na sala antes de deletar.
 // DELETE /api/v1/rooms/:id
 func (h *RoomHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	userID := httputil.GetUserID(r.Context())
@@ -235,9 +399,12 @@ func (h *RoomHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+
 	err := h.roomService.Delete(r.Context(), approom.DeleteInput{
 		RoomID:      room.ID(roomID),
 		RequesterID: user.ID(userID),
+		Force:       force,
 	})
 
 	if err != nil {
@@ -259,16 +426,110 @@ func handleRoomError(w http.ResponseWriter, err error) {
 		httputil.Forbidden(w, "You are not the owner of this room")
 	case errors.Is(err, approom.ErrInvalidCode):
 		httputil.NotFound(w, "Invalid access code")
+	case errors.Is(err, approom.ErrRoomNotPrivate):
+		httputil.BadRequest(w, "Only private rooms have an access code")
+	case errors.Is(err, approom.ErrTooManyAttempts):
+		httputil.TooManyRequests(w, "Too many access code attempts, try again later")
+	case errors.Is(err, approom.ErrInvalidCursor):
+		httputil.BadRequest(w, "Invalid cursor")
+	case errors.Is(err, approom.ErrInvalidAuditAction):
+		httputil.BadRequest(w, "Invalid audit action")
+	case errors.Is(err, approom.ErrInvalidSort):
+		httputil.BadRequest(w, "Invalid sort (use 'newest' or 'viewers')")
+	case errors.Is(err, approom.ErrQueryTooLong):
+		httputil.BadRequest(w, "Search query must be at most 50 characters")
 	case errors.Is(err, room.ErrNameTooShort):
 		httputil.BadRequest(w, "Room name must be at least 3 characters")
 	case errors.Is(err, room.ErrNameTooLong):
 		httputil.BadRequest(w, "Room name must be at most 25 characters")
 	case errors.Is(err, room.ErrInvalidTheme):
 		httputil.BadRequest(w, "Invalid theme")
+	case errors.Is(err, room.ErrThemeLocked):
+		httputil.Error(w, http.StatusForbidden, "THEME_LOCKED", "Unlock the required achievement to use this theme")
+	case errors.Is(err, room.ErrInvalidSlowMode):
+		httputil.BadRequest(w, "Slow mode must be between 0 and 300 seconds")
+	case errors.Is(err, room.ErrInvalidMediaControl):
+		httputil.BadRequest(w, "Media control must be 'owner', 'hosts' or 'everyone'")
+	case errors.Is(err, room.ErrInvalidCategory):
+		httputil.BadRequest(w, "Invalid category (use movies, series, anime, documentary, music, sports, gaming or other)")
+	case errors.Is(err, room.ErrInvalidLanguage):
+		httputil.BadRequest(w, "Invalid language (use a code like 'pt' or 'pt-BR')")
+	case errors.Is(err, room.ErrInvalidContentRating):
+		httputil.BadRequest(w, "Invalid content rating (use 'all', '12+', '16+' or '18+')")
+	case errors.Is(err, room.ErrInvalidTag):
+		httputil.BadRequest(w, "Tags must be 2 to 20 letters, numbers or hyphens")
+	case errors.Is(err, room.ErrTooManyTags):
+		httputil.BadRequest(w, "A room can have at most 5 tags")
 	case errors.Is(err, room.ErrInvalidVisibility):
-		httputil.BadRequest(w, "Invalid visibility (use 'public' or 'private')")
+		httputil.BadRequest(w, "Invalid visibility (use 'public', 'private' or 'approval')")
+	case errors.Is(err, room.ErrRestoreExpired):
+		httputil.Error(w, http.StatusGone, "RESTORE_EXPIRED", "Room can no longer be restored")
+	case errors.Is(err, room.ErrRoomNotDeleted):
+		httputil.Conflict(w, "Room is not deleted")
+	case errors.Is(err, room.ErrTransferNotFound):
+		httputil.NotFound(w, "Transfer not found")
+	case errors.Is(err, room.ErrTransferExpired):
+		httputil.Error(w, http.StatusGone, "TRANSFER_EXPIRED", "Transfer has expired")
+	case errors.Is(err, room.ErrCannotTransferToSelf):
+		httputil.BadRequest(w, "You cannot transfer a room to yourself")
+	case errors.Is(err, room.ErrEventNotFound):
+		httputil.NotFound(w, "Event not found")
+	case errors.Is(err, room.ErrRSVPNotFound):
+		httputil.NotFound(w, "RSVP not found")
+	case errors.Is(err, room.ErrEventTitleTooShort):
+		httputil.BadRequest(w, "Event title must be at least 3 characters")
+	case errors.Is(err, room.ErrEventTitleTooLong):
+		httputil.BadRequest(w, "Event title must be at most 100 characters")
+	case errors.Is(err, room.ErrEventDescriptionLength):
+		httputil.BadRequest(w, "Event description must be at most 1000 characters")
+	case errors.Is(err, room.ErrEventInPast):
+		httputil.BadRequest(w, "Event must start in the future")
+	case errors.Is(err, room.ErrEventStarted):
+		httputil.Conflict(w, "Event has already started")
+	case errors.Is(err, room.ErrInvalidMediaURL):
+		httputil.BadRequest(w, "Invalid media URL (use http or https)")
+	case errors.Is(err, room.ErrInvalidRSVPStatus):
+		httputil.BadRequest(w, "Invalid status (use 'going', 'maybe' or 'declined')")
+	case errors.Is(err, room.ErrInviteNotFound):
+		httputil.NotFound(w, "Invite not found")
+	case errors.Is(err, room.ErrInviteExpired):
+		httputil.Error(w, http.StatusGone, "INVITE_EXPIRED", "Invite has expired")
+	case errors.Is(err, room.ErrInviteRevoked):
+		httputil.Error(w, http.StatusGone, "INVITE_REVOKED", "Invite has been revoked")
+	case errors.Is(err, room.ErrInviteExhausted):
+		httputil.Error(w, http.StatusGone, "INVITE_EXHAUSTED", "Invite has reached its maximum uses")
+	case errors.Is(err, room.ErrInvalidInviteMaxUses):
+		httputil.BadRequest(w, "max_uses must be between 0 and 1000")
+	case errors.Is(err, room.ErrInvalidInviteExpiry):
+		httputil.BadRequest(w, "Invite expiry must be in the future and within 30 days")
+	case errors.Is(err, room.ErrInvalidSeat):
+		httputil.BadRequest(w, "Invalid seat")
+	case errors.Is(err, approom.ErrSeatReserved):
+		httputil.Conflict(w, "Seat is reserved for another member")
+	case errors.Is(err, approom.ErrOwnerSeatPick):
+		httputil.BadRequest(w, "The owner picks their own seat")
 	case errors.Is(err, room.ErrRoomNotEmpty):
-		httputil.BadRequest(w, "Room must be empty to delete")
+		httputil.Conflict(w, "Room must be empty to delete (use force=true to close it)")
+	case errors.Is(err, room.ErrRoomFull):
+		httputil.Error(w, http.StatusConflict, "ROOM_FULL", "Room is full")
+	case errors.Is(err, room.ErrUserBanned):
+		httputil.Forbidden(w, "You are banned from this room")
+	case errors.Is(err, room.ErrBanNotFound):
+		httputil.NotFound(w, "Ban not found")
+	case errors.Is(err, room.ErrCannotBanSelf):
+		httputil.BadRequest(w, "You cannot ban yourself")
+	case errors.Is(err, room.ErrCannotBanOwner):
+		httputil.BadRequest(w, "The room owner cannot be banned")
+	case errors.Is(err, approom.ErrInvalidBanExpiry):
+		httputil.BadRequest(w, "Ban expiry must be in the future")
+	case errors.Is(err, approom.ErrUserNotFound):
+		httputil.NotFound(w, "User not found")
+	case errors.Is(err, approom.ErrForbidden):
+		httputil.Forbidden(w, "You do not have permission to do this in this room")
+	case errors.Is(err, room.ErrInvalidRole):
+		httputil.BadRequest(w, "Invalid role (use 'co_host', 'moderator' or 'member')")
+	case errors.Is(err, room.ErrCannotAssignOwner):
+		httputil.BadRequest(w, "The owner role cannot be assigned")
 	default:
 		httputil.InternalServerError(w, "An unexpected error occurred")
 	}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// RoomSettingsResponse é a representação das configurações da sala na resposta.
+type RoomSettingsResponse struct {
+	SlowModeSeconds int    `json:"slow_mode_seconds"`
+	ChatEnabled     bool   `json:"chat_enabled"`
+	LinksAllowed    bool   `json:"links_allowed"`
+	MediaControl    string `json:"media_control"`
+	GuestsAllowed   bool   `json:"guests_allowed"`
+}
+
+// toRoomSettingsResponse converte Settings para RoomSettingsResponse.
+func toRoomSettingsResponse(s *room.Settings) RoomSettingsResponse {
+	return RoomSettingsResponse{
+		SlowModeSeconds: s.SlowModeSeconds,
+		ChatEnabled:     s.ChatEnabled,
+		LinksAllowed:    s.LinksAllowed,
+		MediaControl:    string(s.MediaControl),
+		GuestsAllowed:   s.GuestsAllowed,
+	}
+}
+
+// GetSettings retorna as configurações da sala.
+// GET /api/v1/rooms/:id/settings
+func (h *RoomHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	settings, err := h.roomService.GetSettings(r.Context(), room.ID(roomID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRoomSettingsResponse(settings))
+}
+
+// UpdateRoomSettingsRequest é o corpo da requisição de alteração das configurações.
+// Campos omitidos mantêm o valor atual.
+type UpdateRoomSettingsRequest struct {
+	SlowModeSeconds *int    `json:"slow_mode_seconds"`
+	ChatEnabled     *bool   `json:"chat_enabled"`
+	LinksAllowed    *bool   `json:"links_allowed"`
+	MediaControl    *string `json:"media_control"` // owner, hosts ou everyone
+	GuestsAllowed   *bool   `json:"guests_allowed"`
+}
+
+// UpdateSettings altera as configurações da sala (apenas o dono).
+// PATCH /api/v1/rooms/:id/settings
+func (h *RoomHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req UpdateRoomSettingsRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	input := approom.UpdateSettingsInput{
+		RoomID:          room.ID(roomID),
+		RequesterID:     user.ID(userID),
+		SlowModeSeconds: req.SlowModeSeconds,
+		ChatEnabled:     req.ChatEnabled,
+		LinksAllowed:    req.LinksAllowed,
+		GuestsAllowed:   req.GuestsAllowed,
+	}
+	if req.MediaControl != nil {
+		mediaControl := room.MediaControl(*req.MediaControl)
+		input.MediaControl = &mediaControl
+	}
+
+	settings, err := h.roomService.UpdateSettings(r.Context(), input)
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toRoomSettingsResponse(settings))
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	apptheme "github.com/vinib1903/cineus-api/internal/app/theme"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// ThemeHandler gerencia as rotas do catálogo de temas.
+type ThemeHandler struct {
+	themeService *apptheme.Service
+}
+
+// NewThemeHandler cria uma nova instância do handler.
+func NewThemeHandler(themeService *apptheme.Service) *ThemeHandler {
+	return &ThemeHandler{themeService: themeService}
+}
+
+// SeatLayoutResponse é a forma das filas de um tema.
+type SeatLayoutResponse struct {
+	Columns  int   `json:"columns"`
+	Aisles   []int `json:"aisles"`
+	MaxSeats int   `json:"max_seats"`
+}
+
+// ThemeResponse é a representação de um tema na resposta.
+type ThemeResponse struct {
+	ID                string             `json:"id"`
+	DisplayName       string             `json:"display_name"`
+	Assets            map[string]string  `json:"assets"`
+	Palette           map[string]string  `json:"palette"`
+	SeatLayout        SeatLayoutResponse `json:"seat_layout"`
+	UnlockAchievement string             `json:"unlock_achievement,omitempty"`
+	SortOrder         int                `json:"sort_order"`
+}
+
+// toThemeResponse converte um ThemeDefinition para ThemeResponse.
+func toThemeResponse(t *room.ThemeDefinition) ThemeResponse {
+	resp := ThemeResponse{
+		ID:                string(t.ID),
+		DisplayName:       t.DisplayName,
+		Assets:            t.Assets,
+		Palette:           t.Palette,
+		UnlockAchievement: t.UnlockAchievement,
+		SortOrder:         t.SortOrder,
+		SeatLayout: SeatLayoutResponse{
+			Columns:  t.SeatColumns,
+			Aisles:   t.SeatAisles,
+			MaxSeats: t.SeatColumns * room.MaxSeatRows,
+		},
+	}
+
+	// Sempre objetos/listas no JSON, nunca null
+	if resp.Assets == nil {
+		resp.Assets = map[string]string{}
+	}
+	if resp.Palette == nil {
+		resp.Palette = map[string]string{}
+	}
+	if resp.SeatLayout.Aisles == nil {
+		resp.SeatLayout.Aisles = []int{}
+	}
+
+	return resp
+}
+
+// List retorna o catálogo de temas.
+// GET /api/v1/themes
+func (h *ThemeHandler) List(w http.ResponseWriter, r *http.Request) {
+	themes := h.themeService.List()
+
+	response := make([]ThemeResponse, len(themes))
+	for i := range themes {
+		response[i] = toThemeResponse(&themes[i])
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// Get retorna um tema do catálogo.
+// GET /api/v1/themes/:id
+func (h *ThemeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	theme, err := h.themeService.Get(room.Theme(chi.URLParam(r, "id")))
+	if err != nil {
+		handleThemeError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toThemeResponse(theme))
+}
+
+// SeatLayoutRequest é a forma das filas no corpo da requisição.
+type SeatLayoutRequest struct {
+	Columns *int  `json:"columns"`
+	Aisles  []int `json:"aisles"`
+}
+
+// ThemeRequest é o corpo da criação e da alteração de um tema.
+// Na alteração, campos ausentes mantêm o valor atual.
+type ThemeRequest struct {
+	ID                string             `json:"id"` // Só na criação
+	DisplayName       *string            `json:"display_name"`
+	Assets            map[string]string  `json:"assets"`
+	Palette           map[string]string  `json:"palette"`
+	SeatLayout        *SeatLayoutRequest `json:"seat_layout"`
+	UnlockAchievement *string            `json:"unlock_achievement"`
+	SortOrder         *int               `json:"sort_order"`
+}
+
+// toInput converte a requisição para a entrada do serviço.
+func (req ThemeRequest) toInput() apptheme.ThemeInput {
+	input := apptheme.ThemeInput{
+		DisplayName:       req.DisplayName,
+		Assets:            req.Assets,
+		Palette:           req.Palette,
+		UnlockAchievement: req.UnlockAchievement,
+		SortOrder:         req.SortOrder,
+	}
+	if req.SeatLayout != nil {
+		input.SeatColumns = req.SeatLayout.Columns
+		input.SeatAisles = req.SeatLayout.Aisles
+	}
+	return input
+}
+
+// Create adiciona um tema ao catálogo (apenas administradores).
+// POST /api/v1/admin/themes
+func (h *ThemeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req ThemeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.ID == "" || req.DisplayName == nil {
+		httputil.BadRequest(w, "Theme ID and display name are required")
+		return
+	}
+
+	theme, err := h.themeService.Create(r.Context(), room.Theme(req.ID), req.toInput())
+	if err != nil {
+		handleThemeError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toThemeResponse(theme))
+}
+
+// Update altera um tema do catálogo (apenas administradores).
+// PATCH /api/v1/admin/themes/:id
+func (h *ThemeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req ThemeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	theme, err := h.themeService.Update(r.Context(), room.Theme(chi.URLParam(r, "id")), req.toInput())
+	if err != nil {
+		handleThemeError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toThemeResponse(theme))
+}
+
+// Delete remove um tema que nenhuma sala usa (apenas administradores).
+// DELETE /api/v1/admin/themes/:id
+func (h *ThemeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.themeService.Delete(r.Context(), room.Theme(chi.URLParam(r, "id"))); err != nil {
+		handleThemeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleThemeError converte erros do catálogo de temas em respostas HTTP.
+func handleThemeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, room.ErrThemeNotFound):
+		httputil.NotFound(w, "Theme not found")
+	case errors.Is(err, room.ErrThemeExists):
+		httputil.Conflict(w, "A theme with this ID already exists")
+	case errors.Is(err, room.ErrThemeInUse):
+		httputil.Conflict(w, "Theme is used by existing rooms")
+	case errors.Is(err, room.ErrCannotDeleteDefault):
+		httputil.BadRequest(w, "The default theme cannot be deleted")
+	case errors.Is(err, room.ErrInvalidThemeID):
+		httputil.BadRequest(w, "Theme ID must be 2-32 lowercase letters, digits, '-' or '_'")
+	case errors.Is(err, room.ErrInvalidThemeName):
+		httputil.BadRequest(w, "Display name must be between 1 and 50 characters")
+	case errors.Is(err, room.ErrInvalidThemeAssets):
+		httputil.BadRequest(w, "Assets must map lowercase keys to http(s) URLs (max 20)")
+	case errors.Is(err, room.ErrInvalidThemePalette):
+		httputil.BadRequest(w, "Palette must map lowercase keys to #RRGGBB colors (max 20)")
+	case errors.Is(err, room.ErrInvalidThemeSeatShape):
+		httputil.BadRequest(w, "Seat layout needs 2-20 columns and increasing aisles between columns")
+	case errors.Is(err, apptheme.ErrUnknownAchievement):
+		httputil.BadRequest(w, "Unlock achievement does not exist")
+	default:
+		httputil.InternalServerError(w, "An unexpected error occurred")
+	}
+}
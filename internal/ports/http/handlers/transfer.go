@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// TransferResponse é a representação de uma transferência de posse na resposta.
+type TransferResponse struct {
+	RoomID     string `json:"room_id"`
+	FromUserID string `json:"from_user_id"`
+	ToUserID   string `json:"to_user_id"`
+	ExpiresAt  string `json:"expires_at"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// toTransferResponse converte uma Transfer para TransferResponse.
+func toTransferResponse(t *room.Transfer) TransferResponse {
+	return TransferResponse{
+		RoomID:     string(t.RoomID),
+		FromUserID: string(t.FromUserID),
+		ToUserID:   string(t.ToUserID),
+		ExpiresAt:  t.ExpiresAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:  t.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// OfferTransferRequest é o corpo da requisição de transferência de posse.
+type OfferTransferRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// OfferTransfer oferece a sala a outro usuário (apenas o dono).
+// POST /api/v1/rooms/:id/transfer
+func (h *RoomHandler) OfferTransfer(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	var req OfferTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.UserID == "" {
+		httputil.BadRequest(w, "User ID is required")
+		return
+	}
+
+	transfer, err := h.roomService.OfferTransfer(r.Context(), approom.OfferTransferInput{
+		RoomID:      room.ID(roomID),
+		RequesterID: user.ID(userID),
+		ToUserID:    user.ID(req.UserID),
+	})
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusCreated, toTransferResponse(transfer))
+}
+
+// GetTransfer retorna a transferência pendente da sala (dono ou destinatário).
+// GET /api/v1/rooms/:id/transfer
+func (h *RoomHandler) GetTransfer(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	transfer, err := h.roomService.GetTransfer(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toTransferResponse(transfer))
+}
+
+// ListIncomingTransfers lista as salas oferecidas ao usuário autenticado.
+// GET /api/v1/rooms/transfers
+func (h *RoomHandler) ListIncomingTransfers(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	transfers, err := h.roomService.ListIncomingTransfers(r.Context(), user.ID(userID))
+	if err != nil {
+		httputil.InternalServerError(w, "Failed to list transfers")
+		return
+	}
+
+	response := make([]TransferResponse, len(transfers))
+	for i, t := range transfers {
+		response[i] = toTransferResponse(t)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// AcceptTransfer aceita a posse da sala oferecida.
+// POST /api/v1/rooms/:id/transfer/accept
+func (h *RoomHandler) AcceptTransfer(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	rm, err := h.roomService.AcceptTransfer(r.Context(), room.ID(roomID), user.ID(userID))
+	if err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	// Agora é o dono, então recebe o código
+	httputil.JSON(w, http.StatusOK, toRoomResponse(rm, true))
+}
+
+// DeclineTransfer recusa a sala oferecida.
+// POST /api/v1/rooms/:id/transfer/decline
+func (h *RoomHandler) DeclineTransfer(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	if err := h.roomService.DeclineTransfer(r.Context(), room.ID(roomID), user.ID(userID)); err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "Transfer declined"})
+}
+
+// CancelTransfer cancela a oferta pendente (apenas o dono).
+// DELETE /api/v1/rooms/:id/transfer
+func (h *RoomHandler) CancelTransfer(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	roomID := chi.URLParam(r, "id")
+	if roomID == "" {
+		httputil.BadRequest(w, "Room ID is required")
+		return
+	}
+
+	if err := h.roomService.CancelTransfer(r.Context(), room.ID(roomID), user.ID(userID)); err != nil {
+		handleRoomError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, map[string]string{"message": "Transfer cancelled"})
+}
@@ -1,20 +1,24 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
+	appuser "github.com/vinib1903/cineus-api/internal/app/user"
 	"github.com/vinib1903/cineus-api/internal/domain/user"
 	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
 )
 
 // UserHandler gerencia as rotas de usuário.
 type UserHandler struct {
-	userRepo user.Repository
+	userService *appuser.Service
 }
 
 // NewUserHandler cria uma nova instância do handler.
-func NewUserHandler(userRepo user.Repository) *UserHandler {
-	return &UserHandler{userRepo: userRepo}
+func NewUserHandler(userService *appuser.Service) *UserHandler {
+	return &UserHandler{userService: userService}
 }
 
 // MeResponse é a resposta do endpoint /me.
@@ -26,6 +30,13 @@ type MeResponse struct {
 	EmailVerified bool   `json:"email_verified"`
 }
 
+// PublicUserResponse é a representação pública de um usuário (sem email).
+type PublicUserResponse struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	XP          int64  `json:"xp"`
+}
+
 // Me retorna os dados do usuário autenticado.
 // GET /api/v1/me
 func (h *UserHandler) Me(w http.ResponseWriter, r *http.Request) {
@@ -37,9 +48,9 @@ func (h *UserHandler) Me(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Buscar o usuário no banco
-	u, err := h.userRepo.GetByID(r.Context(), user.ID(userID))
+	u, err := h.userService.GetByID(r.Context(), user.ID(userID))
 	if err != nil {
-		if err == user.ErrUserNotFound {
+		if errors.Is(err, appuser.ErrUserNotFound) {
 			httputil.NotFound(w, "User not found")
 			return
 		}
@@ -58,3 +69,156 @@ func (h *UserHandler) Me(w http.ResponseWriter, r *http.Request) {
 
 	httputil.JSON(w, http.StatusOK, response)
 }
+
+// Search busca usuários pelo nome de exibição.
+// GET /api/v1/users/search?q=&limit=&offset=
+func (h *UserHandler) Search(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	users, err := h.userService.Search(r.Context(), appuser.SearchInput{
+		Query:       query.Get("q"),
+		RequesterID: user.ID(userID),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		handleUserError(w, err)
+		return
+	}
+
+	response := make([]PublicUserResponse, len(users))
+	for i, u := range users {
+		response[i] = toPublicUserResponse(u)
+	}
+
+	httputil.JSON(w, http.StatusOK, response)
+}
+
+// SettingsResponse é a representação das preferências na resposta.
+type SettingsResponse struct {
+	Locale                 string `json:"locale"`
+	DefaultRoomTheme       string `json:"default_room_theme"`
+	ChatSound              bool   `json:"chat_sound"`
+	Autoplay               bool   `json:"autoplay"`
+	AllowDMsFromNonFriends bool   `json:"allow_dms_from_non_friends"`
+	Discoverable           bool   `json:"discoverable"`
+	NotifyAchievements     bool   `json:"notify_achievements"`
+	HideAdultRooms         bool   `json:"hide_adult_rooms"`
+}
+
+// toSettingsResponse converte Settings para SettingsResponse.
+func toSettingsResponse(s *user.Settings) SettingsResponse {
+	return SettingsResponse{
+		Locale:                 s.Locale,
+		DefaultRoomTheme:       s.DefaultRoomTheme,
+		ChatSound:              s.ChatSound,
+		Autoplay:               s.Autoplay,
+		AllowDMsFromNonFriends: s.AllowDMsFromNonFriends,
+		Discoverable:           s.Discoverable,
+		NotifyAchievements:     s.NotifyAchievements,
+		HideAdultRooms:         s.HideAdultRooms,
+	}
+}
+
+// GetSettings retorna as preferências do usuário autenticado.
+// GET /api/v1/me/settings
+func (h *UserHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	settings, err := h.userService.GetSettings(r.Context(), user.ID(userID))
+	if err != nil {
+		handleUserError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toSettingsResponse(settings))
+}
+
+// UpdateSettingsRequest é o corpo da requisição de alteração de preferências.
+// Campos omitidos mantêm o valor atual.
+type UpdateSettingsRequest struct {
+	Locale                 *string `json:"locale"`
+	DefaultRoomTheme       *string `json:"default_room_theme"`
+	ChatSound              *bool   `json:"chat_sound"`
+	Autoplay               *bool   `json:"autoplay"`
+	AllowDMsFromNonFriends *bool   `json:"allow_dms_from_non_friends"`
+	Discoverable           *bool   `json:"discoverable"`
+	NotifyAchievements     *bool   `json:"notify_achievements"`
+	HideAdultRooms         *bool   `json:"hide_adult_rooms"`
+}
+
+// UpdateSettings altera as preferências do usuário autenticado.
+// PATCH /api/v1/me/settings
+func (h *UserHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Campos desconhecidos são rejeitados (validação do schema)
+	var req UpdateSettingsRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		httputil.BadRequest(w, "Invalid request body")
+		return
+	}
+
+	settings, err := h.userService.UpdateSettings(r.Context(), appuser.UpdateSettingsInput{
+		UserID:                 user.ID(userID),
+		Locale:                 req.Locale,
+		DefaultRoomTheme:       req.DefaultRoomTheme,
+		ChatSound:              req.ChatSound,
+		Autoplay:               req.Autoplay,
+		AllowDMsFromNonFriends: req.AllowDMsFromNonFriends,
+		Discoverable:           req.Discoverable,
+		NotifyAchievements:     req.NotifyAchievements,
+		HideAdultRooms:         req.HideAdultRooms,
+	})
+	if err != nil {
+		handleUserError(w, err)
+		return
+	}
+
+	httputil.JSON(w, http.StatusOK, toSettingsResponse(settings))
+}
+
+// toPublicUserResponse converte um User para PublicUserResponse.
+func toPublicUserResponse(u *user.User) PublicUserResponse {
+	return PublicUserResponse{
+		ID:          string(u.ID),
+		DisplayName: u.DisplayName,
+		XP:          u.XP,
+	}
+}
+
+// handleUserError trata erros do serviço de usuário.
+func handleUserError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, appuser.ErrUserNotFound):
+		httputil.NotFound(w, "User not found")
+	case errors.Is(err, appuser.ErrSearchTooShort):
+		httputil.BadRequest(w, "Search query must be at least 2 characters")
+	case errors.Is(err, appuser.ErrSearchTooLong):
+		httputil.BadRequest(w, "Search query must be at most 50 characters")
+	case errors.Is(err, appuser.ErrInvalidTheme):
+		httputil.BadRequest(w, "Invalid default room theme")
+	case errors.Is(err, user.ErrInvalidLocale):
+		httputil.BadRequest(w, "Unsupported locale")
+	default:
+		httputil.InternalServerError(w, "An unexpected error occurred")
+	}
+}
@@ -0,0 +1,16 @@
+package httputil
+
+import (
+	"net"
+	"net/http"
+)
+
+// ClientIP retorna o IP do cliente, sem a porta.
+// O middleware RealIP já substitui RemoteAddr pelo IP real quando há proxy.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -74,6 +74,11 @@ func Conflict(w http.ResponseWriter, message string) {
 	Error(w, http.StatusConflict, "CONFLICT", message)
 }
 
+// TooManyRequests envia um erro 429.
+func TooManyRequests(w http.ResponseWriter, message string) {
+	Error(w, http.StatusTooManyRequests, "TOO_MANY_REQUESTS", message)
+}
+
 // InternalServerError envia um erro 500.
 func InternalServerError(w http.ResponseWriter, message string) {
 	Error(w, http.StatusInternalServerError, "INTERNAL_ERROR", message)
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http"
+
+	appuser "github.com/vinib1903/cineus-api/internal/app/user"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// RequireAdmin cria um middleware que só deixa passar administradores.
+// Deve vir depois do AuthMiddleware.
+func RequireAdmin(userService *appuser.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID := httputil.GetUserID(r.Context())
+			if userID == "" {
+				httputil.Unauthorized(w, "User not authenticated")
+				return
+			}
+
+			u, err := userService.GetByID(r.Context(), user.ID(userID))
+			if err != nil || !u.IsAdmin {
+				httputil.Forbidden(w, "Admin access required")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
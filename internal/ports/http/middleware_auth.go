@@ -55,3 +55,27 @@ func AuthMiddleware(jwtManager *auth.JWTManager) func(http.Handler) http.Handler
 		})
 	}
 }
+
+// OptionalAuthMiddleware identifica o usuário quando há um token válido,
+// mas deixa a requisição seguir como anônima se não houver.
+func OptionalAuthMiddleware(jwtManager *auth.JWTManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			parts := strings.Split(r.Header.Get("Authorization"), " ")
+			if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := jwtManager.ValidateToken(parts[1])
+			if err != nil || claims.TokenType != auth.AccessToken {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), httputil.UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, httputil.UserEmailKey, claims.Email)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/vinib1903/cineus-api/internal/infra/ratelimit"
+	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
+)
+
+// RateLimit cria um middleware que limita a taxa de requisições.
+// Usuários autenticados são limitados pelo ID; os demais, pelo IP.
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := "ip:" + httputil.ClientIP(r)
+			if userID := httputil.GetUserID(r.Context()); userID != "" {
+				key = "user:" + userID
+			}
+
+			if !limiter.Allow(key) {
+				httputil.TooManyRequests(w, "Too many requests, try again later")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
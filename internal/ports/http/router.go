@@ -1,23 +1,32 @@
 package http
 
 import (
+	"time"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	appachievement "github.com/vinib1903/cineus-api/internal/app/achievement"
 	"github.com/vinib1903/cineus-api/internal/app/auth"
+	appchat "github.com/vinib1903/cineus-api/internal/app/chat"
 	approom "github.com/vinib1903/cineus-api/internal/app/room"
-	"github.com/vinib1903/cineus-api/internal/domain/user"
+	apptheme "github.com/vinib1903/cineus-api/internal/app/theme"
+	appuser "github.com/vinib1903/cineus-api/internal/app/user"
 	infraauth "github.com/vinib1903/cineus-api/internal/infra/auth"
+	"github.com/vinib1903/cineus-api/internal/infra/ratelimit"
 	"github.com/vinib1903/cineus-api/internal/ports/http/handlers"
 	"github.com/vinib1903/cineus-api/internal/ports/ws"
 )
 
 // RouterConfig contém as dependências do router.
 type RouterConfig struct {
-	AuthService *auth.Service
-	RoomService *approom.Service
-	UserRepo    user.Repository
-	JWTManager  *infraauth.JWTManager
-	WSHandler   *ws.Handler
+	AuthService        *auth.Service
+	RoomService        *approom.Service
+	ChatService        *appchat.Service
+	UserService        *appuser.Service
+	AchievementService *appachievement.Service
+	ThemeService       *apptheme.Service
+	JWTManager         *infraauth.JWTManager
+	WSHandler          *ws.Handler
 }
 
 // NewRouter cria e configura o router HTTP.
@@ -34,8 +43,11 @@ func NewRouter(cfg RouterConfig) *chi.Mux {
 	// Handlers
 	healthHandler := handlers.NewHealthHandler()
 	authHandler := handlers.NewAuthHandler(cfg.AuthService)
-	userHandler := handlers.NewUserHandler(cfg.UserRepo)
+	userHandler := handlers.NewUserHandler(cfg.UserService)
 	roomHandler := handlers.NewRoomHandler(cfg.RoomService)
+	chatHandler := handlers.NewChatHandler(cfg.ChatService)
+	achievementHandler := handlers.NewAchievementHandler(cfg.AchievementService)
+	themeHandler := handlers.NewThemeHandler(cfg.ThemeService)
 
 	// Rotas públicas
 	r.Get("/health", healthHandler.Health)
@@ -51,23 +63,114 @@ func NewRouter(cfg RouterConfig) *chi.Mux {
 		// Room routes
 		r.Route("/rooms", func(r chi.Router) {
 			// Rotas públicas
-			r.Get("/", roomHandler.ListPublic)
+			r.With(OptionalAuthMiddleware(cfg.JWTManager)).Get("/", roomHandler.ListPublic)
 			r.Get("/{id}", roomHandler.GetByID)
+			r.Get("/{id}/settings", roomHandler.GetSettings)
 
 			// Rotas protegidas
 			r.Group(func(r chi.Router) {
 				r.Use(AuthMiddleware(cfg.JWTManager))
 				r.Post("/", roomHandler.Create)
 				r.Get("/my", roomHandler.ListMy)
+				r.Get("/my/deleted", roomHandler.ListDeleted)
 				r.Post("/join", roomHandler.JoinByCode)
+				r.Patch("/{id}", roomHandler.Update)
 				r.Delete("/{id}", roomHandler.Delete)
+				r.Post("/{id}/restore", roomHandler.Restore)
+				r.Post("/{id}/access-code", roomHandler.RegenerateAccessCode)
+				r.Patch("/{id}/settings", roomHandler.UpdateSettings)
+
+				// Banimentos
+				r.Post("/{id}/bans", roomHandler.Ban)
+				r.Get("/{id}/bans", roomHandler.ListBans)
+				r.Delete("/{id}/bans/{userId}", roomHandler.Unban)
+
+				// Membros e papéis
+				r.Get("/{id}/members", roomHandler.ListMembers)
+				r.Put("/{id}/members/{userId}/role", roomHandler.SetRole)
+				r.Put("/{id}/members/{userId}/seat", roomHandler.AssignSeat)
+				r.Delete("/{id}/members/{userId}/seat", roomHandler.ClearSeat)
+
+				// Transferência de posse
+				r.Get("/transfers", roomHandler.ListIncomingTransfers)
+				r.Post("/{id}/transfer", roomHandler.OfferTransfer)
+				r.Get("/{id}/transfer", roomHandler.GetTransfer)
+				r.Delete("/{id}/transfer", roomHandler.CancelTransfer)
+				r.Post("/{id}/transfer/accept", roomHandler.AcceptTransfer)
+				r.Post("/{id}/transfer/decline", roomHandler.DeclineTransfer)
+
+				// Sessões agendadas
+				r.Post("/{id}/events", roomHandler.CreateEvent)
+				r.Get("/{id}/events", roomHandler.ListEvents)
+				r.Get("/{id}/events/calendar.ics", roomHandler.ExportEvents)
+				r.Get("/{id}/events/{eventId}", roomHandler.GetEvent)
+				r.Patch("/{id}/events/{eventId}", roomHandler.UpdateEvent)
+				r.Delete("/{id}/events/{eventId}", roomHandler.DeleteEvent)
+				r.Get("/{id}/events/{eventId}/calendar.ics", roomHandler.ExportEvent)
+				r.Put("/{id}/events/{eventId}/rsvp", roomHandler.RSVP)
+				r.Delete("/{id}/events/{eventId}/rsvp", roomHandler.CancelRSVP)
+				r.Get("/{id}/events/{eventId}/rsvps", roomHandler.ListRSVPs)
+
+				// Convites
+				r.Post("/{id}/invites", roomHandler.CreateInvite)
+				r.Get("/{id}/invites", roomHandler.ListInvites)
+				r.Delete("/{id}/invites/{inviteId}", roomHandler.RevokeInvite)
+
+				// Log de auditoria da moderação
+				r.Get("/{id}/audit", roomHandler.ListAudit)
+
+				// Histórico do chat
+				r.Get("/{id}/messages", chatHandler.ListRoomMessages)
 			})
 		})
 
+		// Catálogo de temas (público)
+		r.Get("/themes", themeHandler.List)
+		r.Get("/themes/{id}", themeHandler.Get)
+
+		// Log de auditoria de todas as salas (admins)
+		r.Route("/admin/audit", func(r chi.Router) {
+			r.Use(AuthMiddleware(cfg.JWTManager))
+			r.Use(RequireAdmin(cfg.UserService))
+			r.Get("/", roomHandler.ListAllAudit)
+		})
+
+		// Administração do catálogo de temas
+		r.Route("/admin/themes", func(r chi.Router) {
+			r.Use(AuthMiddleware(cfg.JWTManager))
+			r.Use(RequireAdmin(cfg.UserService))
+			r.Post("/", themeHandler.Create)
+			r.Patch("/{id}", themeHandler.Update)
+			r.Delete("/{id}", themeHandler.Delete)
+		})
+
+		// Métricas da limpeza de salas deletadas
+		r.Get("/stats/purge", roomHandler.PurgeStats)
+
+		// Badge routes (públicas)
+		r.Get("/badges", achievementHandler.Catalog)
+		r.Get("/users/{id}/badges", achievementHandler.ListByUser)
+
 		// User routes (protegidas)
 		r.Group(func(r chi.Router) {
 			r.Use(AuthMiddleware(cfg.JWTManager))
 			r.Get("/me", userHandler.Me)
+			r.Get("/me/settings", userHandler.GetSettings)
+			r.Patch("/me/settings", userHandler.UpdateSettings)
+
+			// Aceitar convite de sala
+			r.Post("/invites/{token}/accept", roomHandler.AcceptInvite)
+
+			// Busca de usuários (limitada a 30 requisições por minuto)
+			r.With(RateLimit(ratelimit.NewLimiter(30, time.Minute))).
+				Get("/users/search", userHandler.Search)
+
+			// Mensagens diretas (envio limitado a 30 por minuto)
+			r.Get("/me/messages/unread", chatHandler.CountUnread)
+			r.Get("/me/conversations/{userId}/messages", chatHandler.ListConversation)
+			r.With(RateLimit(ratelimit.NewLimiter(30, time.Minute))).
+				Post("/me/conversations/{userId}/messages", chatHandler.SendDirectMessage)
+			r.Post("/me/conversations/{userId}/read", chatHandler.MarkConversationRead)
 		})
 	})
 
@@ -80,6 +183,7 @@ func NewRouter(cfg RouterConfig) *chi.Mux {
 		r.Group(func(r chi.Router) {
 			r.Use(AuthMiddleware(cfg.JWTManager))
 			r.Get("/room/{roomId}", cfg.WSHandler.HandleConnection)
+			r.Get("/me", cfg.WSHandler.HandleUserConnection)
 		})
 	})
 
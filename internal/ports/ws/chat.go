@@ -0,0 +1,59 @@
+package ws
+
+import (
+	"context"
+	"log"
+
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+// chatHistorySize é quantas mensagens recentes quem entra na sala recebe.
+const chatHistorySize = 50
+
+// loadChatHistory busca as últimas mensagens da sala para quando ela abrir.
+// Em caso de erro a sala abre sem histórico.
+func (h *Hub) loadChatHistory(ctx context.Context, roomID room.ID) []*chat.Message {
+	messages, err := h.chat.RecentRoomMessages(ctx, roomID, chatHistorySize)
+	if err != nil {
+		log.Printf("Hub: failed to load chat history for room %s: %v", roomID, err)
+		return nil
+	}
+	return messages
+}
+
+// rememberMessage guarda uma mensagem no histórico recente da sala.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) rememberMessage(msg ChatMessagePayload) {
+	h.history = append(h.history, msg)
+	if len(h.history) > chatHistorySize {
+		h.history = h.history[len(h.history)-chatHistorySize:]
+	}
+}
+
+// recentMessages copia o histórico recente da sala.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) recentMessages() []ChatMessagePayload {
+	messages := make([]ChatMessagePayload, len(h.history))
+	copy(messages, h.history)
+	return messages
+}
+
+// chatMessageInfo monta uma mensagem salva para os clientes.
+func chatMessageInfo(msg *chat.Message) ChatMessagePayload {
+	return ChatMessagePayload{
+		ID:          string(msg.ID),
+		UserID:      string(msg.UserID),
+		DisplayName: displayNameFor(string(msg.UserID)),
+		Content:     msg.Content,
+		CreatedAt:   msg.CreatedAt,
+	}
+}
+
+// displayNameFor monta o nome temporário exibido para um usuário.
+func displayNameFor(userID string) string {
+	if len(userID) > 8 {
+		userID = userID[:8]
+	}
+	return "User-" + userID
+}
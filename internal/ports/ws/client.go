@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/coder/websocket"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
 )
 
 const (
@@ -25,6 +26,9 @@ const (
 
 	// Tamanho do buffer do canal de envio
 	sendBufferSize = 256
+
+	// Tamanho máximo do motivo no frame de fechamento
+	maxCloseReasonLength = 123
 )
 
 // Client representa uma conexão WebSocket de um usuário.
@@ -42,8 +46,15 @@ type Client struct {
 	userID      string
 	displayName string
 	seatID      string
+	role        room.Role
+	spectator   bool // Sem direito a assento (sala lotada)
+	forfeitSeat bool // Saiu expulso/banido: o assento não fica guardado
+	waiting     bool // Na sala de espera, aguardando aprovação
+
+	// Momento em que o cliente entrou na sala
+	joinedAt time.Time
 
-	// Mutex para proteger o seatID
+	// Mutex para proteger seatID, role, spectator, forfeitSeat e waiting
 	mu sync.RWMutex
 
 	// Contexto para cancelamento
@@ -52,7 +63,7 @@ type Client struct {
 }
 
 // NewClient cria um novo cliente.
-func NewClient(hub *RoomHub, conn *websocket.Conn, userID, displayName string) *Client {
+func NewClient(hub *RoomHub, conn *websocket.Conn, userID, displayName string, role room.Role) *Client {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Client{
@@ -61,6 +72,8 @@ func NewClient(hub *RoomHub, conn *websocket.Conn, userID, displayName string) *
 		send:        make(chan []byte, sendBufferSize),
 		userID:      userID,
 		displayName: displayName,
+		role:        role,
+		joinedAt:    time.Now(),
 		ctx:         ctx,
 		cancel:      cancel,
 	}
@@ -90,6 +103,62 @@ func (c *Client) SetSeatID(seatID string) {
 	c.seatID = seatID
 }
 
+// GetRole retorna o papel do usuário na sala (thread-safe).
+func (c *Client) GetRole() room.Role {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.role
+}
+
+// SetRole define o papel do usuário na sala (thread-safe).
+func (c *Client) SetRole(role room.Role) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.role = role
+}
+
+// IsSpectator informa se o cliente é espectador (thread-safe).
+func (c *Client) IsSpectator() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.spectator
+}
+
+// SetSpectator define se o cliente é espectador (thread-safe).
+func (c *Client) SetSpectator(spectator bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.spectator = spectator
+}
+
+// ForfeitSeat faz o cliente perder o assento ao sair, sem tempo de tolerância.
+func (c *Client) ForfeitSeat() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forfeitSeat = true
+}
+
+// ForfeitsSeat indica se o assento deve ser liberado na saída.
+func (c *Client) ForfeitsSeat() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.forfeitSeat
+}
+
+// IsWaiting informa se o cliente está aguardando aprovação (thread-safe).
+func (c *Client) IsWaiting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.waiting
+}
+
+// SetWaiting define se o cliente está aguardando aprovação (thread-safe).
+func (c *Client) SetWaiting(waiting bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waiting = waiting
+}
+
 // Run inicia as goroutines de leitura e escrita.
 func (c *Client) Run() {
 	// Inicia a goroutine de escrita
@@ -103,7 +172,7 @@ func (c *Client) Run() {
 func (c *Client) readPump() {
 	// Quando sair desta função, limpa tudo
 	defer func() {
-		c.hub.unregister <- c
+		c.hub.Unregister(c)
 		c.conn.Close(websocket.StatusNormalClosure, "connection closed")
 		c.cancel()
 	}()
@@ -216,6 +285,30 @@ func (c *Client) SendError(code, message string) {
 	}))
 }
 
+// Disconnect envia uma última mensagem e fecha a conexão com o código informado.
+// Usado quando o servidor tira o cliente da sala (ban, kick, etc.).
+func (c *Client) Disconnect(msg *OutgoingMessage, status websocket.StatusCode, reason string) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Client %s: failed to marshal message: %v", c.userID, err)
+		data = nil
+	}
+
+	// O motivo do frame de fechamento é limitado a 123 bytes
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+	}
+
+	go func() {
+		if data != nil {
+			ctx, cancel := context.WithTimeout(c.ctx, writeWait)
+			c.conn.Write(ctx, websocket.MessageText, data)
+			cancel()
+		}
+		c.conn.Close(status, reason)
+	}()
+}
+
 // Close fecha a conexão do cliente.
 func (c *Client) Close() {
 	c.cancel()
@@ -1,26 +1,39 @@
 package ws
 
 import (
+	"errors"
 	"log"
 	"net/http"
 
 	"github.com/coder/websocket"
 	"github.com/go-chi/chi/v5"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
 	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
 	"github.com/vinib1903/cineus-api/internal/ports/http/httputil"
 )
 
+// maxRegisterAttempts é quantas vezes a conexão tenta entrar na sala
+// quando ela está sendo encerrada por inatividade.
+const maxRegisterAttempts = 3
+
 // Handler gerencia as conexões WebSocket.
 type Handler struct {
-	hub      *Hub
-	roomRepo room.Repository
+	hub        *Hub
+	userHub    *UserHub
+	roomRepo   room.Repository
+	banRepo    room.BanRepository
+	memberRepo room.MemberRepository
 }
 
 // NewHandler cria um novo handler WebSocket.
-func NewHandler(hub *Hub, roomRepo room.Repository) *Handler {
+func NewHandler(hub *Hub, userHub *UserHub, roomRepo room.Repository, banRepo room.BanRepository, memberRepo room.MemberRepository) *Handler {
 	return &Handler{
-		hub:      hub,
-		roomRepo: roomRepo,
+		hub:        hub,
+		userHub:    userHub,
+		roomRepo:   roomRepo,
+		banRepo:    banRepo,
+		memberRepo: memberRepo,
 	}
 }
 
@@ -59,7 +72,62 @@ func (h *Handler) HandleConnection(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Printf("WebSocket: found room %s (%s)", rm.ID, rm.Name)
 
-	// 4. Fazer o upgrade da conexão HTTP para WebSocket
+	// 4. Verificar se o usuário está banido
+	banned, err := h.banRepo.IsUserBanned(r.Context(), rm.ID, user.ID(userID))
+	if err != nil {
+		log.Printf("WebSocket: failed to check ban for user %s: %v", userID, err)
+		httputil.InternalServerError(w, "Failed to join room")
+		return
+	}
+	if banned {
+		log.Printf("WebSocket: user %s is banned from room %s", userID, roomID)
+		httputil.Forbidden(w, "You are banned from this room")
+		return
+	}
+
+	// Verificar se o usuário foi expulso recentemente
+	if roomHub := h.hub.GetRoom(roomID); roomHub != nil {
+		if _, cooling := roomHub.KickCooldown(userID); cooling {
+			log.Printf("WebSocket: user %s was kicked from room %s recently", userID, roomID)
+			httputil.Forbidden(w, "You were kicked from this room, try again later")
+			return
+		}
+	}
+
+	// Buscar o papel do usuário na sala
+	member, err := h.memberRepo.Get(r.Context(), rm.ID, user.ID(userID))
+	if err != nil && !errors.Is(err, room.ErrMemberNotFound) {
+		log.Printf("WebSocket: failed to load role for user %s: %v", userID, err)
+		httputil.InternalServerError(w, "Failed to join room")
+		return
+	}
+	role := rm.RoleOf(user.ID(userID), member)
+
+	// Salas privadas exigem ser membro (entrou pelo código ou por convite)
+	if rm.IsPrivate() && !rm.IsOwner(user.ID(userID)) && member == nil {
+		log.Printf("WebSocket: user %s is not a member of private room %s", userID, roomID)
+		httputil.Forbidden(w, "Join this room with its access code or an invite first")
+		return
+	}
+
+	// Salas só para membros não recebem convidados (quem já está na sala continua)
+	if !rm.Settings.GuestsAllowed && !rm.IsOwner(user.ID(userID)) && member == nil {
+		log.Printf("WebSocket: user %s is not a member of members-only room %s", userID, roomID)
+		httputil.Forbidden(w, "This room is open to members only")
+		return
+	}
+
+	// Salas com aprovação: membros e quem pode aprovar entram direto
+	needsApproval := rm.RequiresApproval() && member == nil && !role.Can(room.PermAdmit)
+
+	// Verificar se há vaga na sala
+	if !h.hub.CanAdmit(rm.ID, user.ID(userID)) {
+		log.Printf("WebSocket: room %s is full", roomID)
+		httputil.Error(w, http.StatusConflict, "ROOM_FULL", "Room is full")
+		return
+	}
+
+	// 5. Fazer o upgrade da conexão HTTP para WebSocket
 	log.Println("WebSocket: attempting to accept connection...")
 	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
 		InsecureSkipVerify: true,
@@ -70,37 +138,106 @@ func (h *Handler) HandleConnection(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Println("WebSocket: connection accepted!")
 
-	// 5. Obter ou criar o RoomHub
-	roomHub := h.hub.GetOrCreateRoom(RoomConfig{
-		RoomID:    string(rm.ID),
-		RoomName:  rm.Name,
-		RoomTheme: string(rm.Theme),
-		OwnerID:   string(rm.OwnerID),
-		MaxSeats:  rm.MaxSeats,
-	})
-
 	// 6. Criar displayName temporário
-	displayName := "User-" + userID[:8]
-
-	// 7. Criar o cliente
-	client := NewClient(roomHub, conn, userID, displayName)
+	displayName := displayNameFor(userID)
+
+	// 7. Obter ou criar o RoomHub, criar o cliente e registrar.
+	// Se a sala for encerrada por inatividade nesse meio tempo, tenta de novo.
+	var client *Client
+	for attempt := 0; attempt < maxRegisterAttempts && client == nil; attempt++ {
+		// As reservas e o histórico do chat só são carregados quando a sala abre
+		var reserved map[string]string
+		var history []*chat.Message
+		if h.hub.GetRoom(roomID) == nil {
+			reserved = h.loadReservedSeats(r, rm.ID)
+			history = h.hub.loadChatHistory(r.Context(), rm.ID)
+		}
+
+		roomHub := h.hub.GetOrCreateRoom(RoomConfig{
+			RoomID:        string(rm.ID),
+			RoomName:      rm.Name,
+			RoomTheme:     string(rm.Theme),
+			OwnerID:       string(rm.OwnerID),
+			MaxSeats:      rm.MaxSeats,
+			ReservedSeats: reserved,
+			Settings:      rm.Settings,
+			History:       history,
+		})
+
+		c := NewClient(roomHub, conn, userID, displayName, role)
+
+		// Em salas com aprovação, quem não é membro espera alguém liberar a entrada
+		var entered bool
+		if needsApproval {
+			entered = roomHub.Knock(c)
+		} else {
+			entered = roomHub.Register(c)
+		}
+		if entered {
+			client = c
+		}
+	}
 
-	// 8. Registrar o cliente
-	roomHub.register <- client
+	if client == nil {
+		log.Printf("WebSocket: failed to register user %s in room %s", userID, roomID)
+		conn.Close(websocket.StatusTryAgainLater, "room unavailable")
+		return
+	}
 
 	log.Printf("WebSocket: user %s connected to room %s", userID, roomID)
 
-	// 9. Iniciar (bloqueia até desconectar)
+	// 8. Iniciar (bloqueia até desconectar)
 	client.Run()
 
 	log.Printf("WebSocket: user %s disconnected from room %s", userID, roomID)
 }
 
+// HandleUserConnection abre a conexão pessoal do usuário (fora das salas),
+// por onde chegam mensagens diretas, confirmações de leitura e notificações.
+// GET /ws/me
+func (h *Handler) HandleUserConnection(w http.ResponseWriter, r *http.Request) {
+	userID := httputil.GetUserID(r.Context())
+	if userID == "" {
+		httputil.Unauthorized(w, "Authentication required")
+		return
+	}
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		log.Printf("WebSocket: failed to accept user connection: %v", err)
+		return
+	}
+
+	// Bloqueia até desconectar
+	h.userHub.Serve(conn, userID)
+}
+
+// loadReservedSeats busca os assentos reservados para membros da sala.
+// Em caso de erro a sala abre sem reservas.
+func (h *Handler) loadReservedSeats(r *http.Request, roomID room.ID) map[string]string {
+	members, err := h.memberRepo.ListByRoom(r.Context(), roomID)
+	if err != nil {
+		log.Printf("WebSocket: failed to load seat reservations for room %s: %v", roomID, err)
+		return nil
+	}
+
+	reserved := make(map[string]string)
+	for _, m := range members {
+		if m.SeatID != "" {
+			reserved[m.SeatID] = string(m.UserID)
+		}
+	}
+	return reserved
+}
+
 // GetStats retorna estatísticas do WebSocket.
 func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
 	stats := map[string]int{
-		"rooms":   h.hub.GetRoomCount(),
-		"clients": h.hub.GetTotalClients(),
+		"rooms":            h.hub.GetRoomCount(),
+		"clients":          h.hub.GetTotalClients(),
+		"user_connections": h.userHub.ConnectionCount(),
 	}
 	httputil.JSON(w, http.StatusOK, stats)
 }
@@ -3,6 +3,16 @@ package ws
 import (
 	"log"
 	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	appchat "github.com/vinib1903/cineus-api/internal/app/chat"
+	"github.com/vinib1903/cineus-api/internal/app/events"
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
 )
 
 // Hub é o gerenciador global de todas as salas.
@@ -12,15 +22,58 @@ type Hub struct {
 
 	// Mutex para proteger o mapa
 	mu sync.RWMutex
+
+	// Publicador de eventos de domínio (conquistas, etc.)
+	events events.Publisher
+
+	// Serviço de chat (grava as mensagens das salas)
+	chat *appchat.Service
+
+	// Serviço de salas (log de auditoria das ações feitas ao vivo)
+	roomService *approom.Service
+
+	// Opções aplicadas a todas as salas
+	opts HubOptions
+
+	// Vídeos pré-carregados para salas ainda sem ninguém conectado
+	pendingMedia map[string]*MediaState
+}
+
+// pendingMediaTTL é por quanto tempo um vídeo pré-carregado espera a sala abrir.
+const pendingMediaTTL = 6 * time.Hour
+
+// HubOptions são as opções do hub global.
+type HubOptions struct {
+	// Vagas de espectador além dos assentos de cada sala
+	SpectatorSlots int
+
+	// Limite de assentos por sala no servidor (0 = sem limite além do layout)
+	MaxSeats int
+
+	// Tempo que o assento de quem caiu fica guardado (0 = libera na hora)
+	SeatHoldGrace time.Duration
+
+	// Tempo que uma sala vazia continua ativa (mantém o player) antes de ser encerrada
+	IdleTimeout time.Duration
 }
 
 // NewHub cria um novo hub global.
-func NewHub() *Hub {
+func NewHub(publisher events.Publisher, chatService *appchat.Service, opts HubOptions) *Hub {
 	return &Hub{
-		rooms: make(map[string]*RoomHub),
+		rooms:        make(map[string]*RoomHub),
+		events:       publisher,
+		chat:         chatService,
+		opts:         opts,
+		pendingMedia: make(map[string]*MediaState),
 	}
 }
 
+// SetRoomService liga o hub ao serviço de salas.
+// Feito depois da criação porque o serviço também depende do hub (LiveRooms).
+func (h *Hub) SetRoomService(roomService *approom.Service) {
+	h.roomService = roomService
+}
+
 // RoomConfig contém as configurações para criar uma sala.
 type RoomConfig struct {
 	RoomID    string
@@ -28,6 +81,15 @@ type RoomConfig struct {
 	RoomTheme string
 	OwnerID   string
 	MaxSeats  int
+
+	// Assentos reservados pelo dono ou por convite: seatID -> userID
+	ReservedSeats map[string]string
+
+	// Configurações da sala
+	Settings room.Settings
+
+	// Últimas mensagens do chat (das mais antigas para as mais recentes)
+	History []*chat.Message
 }
 
 // GetOrCreateRoom retorna uma sala existente ou cria uma nova.
@@ -50,9 +112,32 @@ func (h *Hub) GetOrCreateRoom(cfg RoomConfig) *RoomHub {
 	}
 
 	// Criar nova sala
-	room := NewRoomHub(h, cfg.RoomID, cfg.RoomName, cfg.RoomTheme, cfg.OwnerID, cfg.MaxSeats)
+	maxSeats := cfg.MaxSeats
+	if h.opts.MaxSeats > 0 && maxSeats > h.opts.MaxSeats {
+		maxSeats = h.opts.MaxSeats
+	}
+	room := NewRoomHub(h, cfg.RoomID, cfg.RoomName, cfg.RoomTheme, cfg.OwnerID, maxSeats, h.opts.SpectatorSlots, h.opts.IdleTimeout, h.opts.SeatHoldGrace)
+	room.settings = cfg.Settings
+	for _, msg := range cfg.History {
+		room.rememberMessage(chatMessageInfo(msg))
+	}
 	h.rooms[cfg.RoomID] = room
 
+	// Reservas fora do layout atual (tema ou limite mudou) são ignoradas
+	for seatID, userID := range cfg.ReservedSeats {
+		if _, exists := room.seats[seatID]; exists {
+			room.reserved[seatID] = userID
+		}
+	}
+
+	// Aplicar vídeo agendado enquanto a sala estava fechada
+	if media, exists := h.pendingMedia[cfg.RoomID]; exists {
+		delete(h.pendingMedia, cfg.RoomID)
+		if time.Since(media.UpdatedAt) < pendingMediaTTL {
+			room.mediaState = media
+		}
+	}
+
 	// Iniciar o loop da sala em uma goroutine
 	go room.Run()
 
@@ -68,13 +153,146 @@ func (h *Hub) GetRoom(roomID string) *RoomHub {
 	return h.rooms[roomID]
 }
 
-// removeRoom remove uma sala do hub (chamado quando a sala fica vazia).
-func (h *Hub) removeRoom(roomID string) {
+// removeRoom remove uma sala do hub (chamado quando a sala é encerrada).
+func (h *Hub) removeRoom(roomHub *RoomHub) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	delete(h.rooms, roomID)
-	log.Printf("Hub: removed room %s", roomID)
+	if h.rooms[roomHub.roomID] == roomHub {
+		delete(h.rooms, roomHub.roomID)
+		log.Printf("Hub: removed room %s", roomHub.roomID)
+	}
+}
+
+// UpdateRoomInfo propaga nome e tema para uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) UpdateRoomInfo(roomID room.ID, name string, theme room.Theme) {
+	if roomHub := h.GetRoom(string(roomID)); roomHub != nil {
+		roomHub.UpdateInfo(name, string(theme))
+	}
+}
+
+// UpdateRoomSettings aplica as novas configurações em uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) UpdateRoomSettings(roomID room.ID, settings room.Settings) {
+	if roomHub := h.GetRoom(string(roomID)); roomHub != nil {
+		roomHub.UpdateSettings(settings)
+	}
+}
+
+// DisconnectBannedUser avisa e desconecta um usuário banido de uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) DisconnectBannedUser(ban *room.Ban) {
+	roomHub := h.GetRoom(string(ban.RoomID))
+	if roomHub == nil {
+		return
+	}
+
+	msg := NewOutgoingMessage(TypeBanned, BannedPayload{
+		RoomID:    string(ban.RoomID),
+		Reason:    ban.Reason,
+		ExpiresAt: ban.ExpiresAt,
+	})
+
+	roomHub.DisconnectUser(string(ban.UserID), msg, websocket.StatusPolicyViolation, "banned from room")
+}
+
+// ClientCount retorna quantos usuários estão conectados em uma sala.
+// Implementa approom.LiveRooms.
+func (h *Hub) ClientCount(roomID room.ID) int {
+	roomHub := h.GetRoom(string(roomID))
+	if roomHub == nil {
+		return 0
+	}
+	return roomHub.ClientCount()
+}
+
+// CloseRoom avisa todos os conectados em uma sala e fecha suas conexões.
+// Implementa approom.LiveRooms.
+func (h *Hub) CloseRoom(roomID room.ID, reason string) {
+	roomHub := h.GetRoom(string(roomID))
+	if roomHub == nil {
+		return
+	}
+
+	msg := NewOutgoingMessage(TypeRoomClosed, RoomClosedPayload{
+		RoomID: string(roomID),
+		Reason: reason,
+	})
+
+	roomHub.DisconnectAll(msg, websocket.StatusGoingAway, "room closed")
+}
+
+// CanAdmit verifica se há vaga para o usuário em uma sala.
+// Salas sem ninguém conectado sempre têm vaga.
+// Implementa approom.LiveRooms.
+func (h *Hub) CanAdmit(roomID room.ID, userID user.ID) bool {
+	roomHub := h.GetRoom(string(roomID))
+	if roomHub == nil {
+		return true
+	}
+	return roomHub.CanAdmit(string(userID))
+}
+
+// SetUserRole aplica o novo papel de um usuário em uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) SetUserRole(roomID room.ID, userID user.ID, role room.Role) {
+	if roomHub := h.GetRoom(string(roomID)); roomHub != nil {
+		roomHub.SetUserRole(string(userID), role)
+	}
+}
+
+// ReserveSeat aplica a reserva de assento de um usuário em uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) ReserveSeat(roomID room.ID, userID user.ID, seatID string) {
+	if roomHub := h.GetRoom(string(roomID)); roomHub != nil {
+		roomHub.ReserveSeat(string(userID), seatID)
+	}
+}
+
+// TransferOwnership aplica o novo dono em uma sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) TransferOwnership(roomID room.ID, newOwnerID user.ID) {
+	if roomHub := h.GetRoom(string(roomID)); roomHub != nil {
+		roomHub.TransferOwnership(string(newOwnerID))
+	}
+}
+
+// PreloadMedia carrega um vídeo no player de uma sala, pausado no início.
+// Se a sala não estiver ativa, o vídeo fica guardado até ela abrir.
+// Implementa approom.LiveRooms.
+func (h *Hub) PreloadMedia(roomID room.ID, videoURL, videoTitle string) {
+	media := &MediaState{
+		VideoURL:    videoURL,
+		VideoTitle:  videoTitle,
+		IsPlaying:   false,
+		CurrentTime: 0,
+		UpdatedAt:   time.Now(),
+	}
+
+	h.mu.Lock()
+	roomHub, exists := h.rooms[string(roomID)]
+	if !exists {
+		h.pendingMedia[string(roomID)] = media
+	}
+	h.mu.Unlock()
+
+	if exists {
+		roomHub.LoadMedia(*media)
+	}
+}
+
+// Activity retorna a ocupação e o que está passando em cada sala ativa.
+// Implementa approom.LiveRooms.
+func (h *Hub) Activity() map[room.ID]approom.RoomActivity {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	activity := make(map[room.ID]approom.RoomActivity, len(h.rooms))
+	for roomID, roomHub := range h.rooms {
+		activity[room.ID(roomID)] = roomHub.Activity()
+	}
+	return activity
 }
 
 // GetRoomCount retorna o número de salas ativas.
@@ -98,3 +316,28 @@ func (h *Hub) GetTotalClients() int {
 	return total
 }
 
+// PushToUser entrega uma notificação em uma das salas em que o usuário está.
+// Uma só: o cliente mostra a mesma notificação em qualquer sala aberta.
+// Implementa notification.Pusher.
+func (h *Hub) PushToUser(userID user.ID, n notification.Notification) bool {
+	msg := NewOutgoingMessage(MessageType(n.Type), NotificationPayload{
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		CreatedAt: n.CreatedAt,
+	})
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		client, exists := room.clients[string(userID)]
+		room.mu.RUnlock()
+		if exists {
+			client.Send(msg)
+			return true
+		}
+	}
+	return false
+}
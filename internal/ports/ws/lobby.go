@@ -0,0 +1,315 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+const (
+	// Tempo que um pedido de entrada espera resposta
+	knockTimeout = 5 * time.Minute
+
+	// Tempo que quem teve a entrada recusada espera para bater de novo
+	knockDeniedCooldown = time.Minute
+
+	// Máximo de pessoas esperando aprovação ao mesmo tempo
+	maxLobbySize = 50
+)
+
+// Resultados de um pedido de entrada.
+const (
+	KnockAdmitted = "admitted"
+	KnockDenied   = "denied"
+	KnockCanceled = "canceled" // Desistiu (desconectou)
+	KnockExpired  = "expired"
+	KnockRemoved  = "removed" // Tirado da espera (ex: banido)
+)
+
+// Knock coloca o cliente na sala de espera até alguém aprovar a entrada.
+// Retorna false se a sala já foi encerrada (o cliente deve buscar a sala de novo).
+func (h *RoomHub) Knock(client *Client) bool {
+	select {
+	case h.knock <- client:
+		return true
+	case <-h.done:
+		return false
+	}
+}
+
+// handleKnock coloca um cliente na sala de espera e avisa quem pode aprovar.
+// Quem já foi aprovado nesta sessão (ex: reconexão) entra direto.
+func (h *RoomHub) handleKnock(client *Client) {
+	h.mu.Lock()
+
+	if h.admitted[client.userID] {
+		h.mu.Unlock()
+		h.handleRegister(client)
+		return
+	}
+
+	if until, exists := h.deniedUntil[client.userID]; exists && time.Now().Before(until) {
+		h.mu.Unlock()
+		client.Disconnect(NewOutgoingMessage(TypeKnockDenied, KnockDeniedPayload{
+			RoomID:     h.roomID,
+			RetryAfter: &until,
+		}), websocket.StatusPolicyViolation, "entry denied")
+		return
+	}
+
+	// Uma nova tentativa substitui a conexão anterior na espera
+	if previous, exists := h.lobby[client.userID]; exists {
+		previous.Close()
+	} else if len(h.lobby) >= maxLobbySize {
+		h.mu.Unlock()
+		client.Disconnect(NewOutgoingMessage(TypeError, ErrorPayload{
+			Code:    "LOBBY_FULL",
+			Message: "Too many people waiting to enter, try again later",
+		}), websocket.StatusTryAgainLater, "lobby is full")
+		return
+	}
+
+	client.SetWaiting(true)
+	h.lobby[client.userID] = client
+	waiting := len(h.lobby)
+	knock := knockInfo(client)
+	waitingPayload := WaitingApprovalPayload{
+		RoomID:    h.roomID,
+		RoomName:  h.roomName,
+		ExpiresAt: knock.KnockedAt.Add(knockTimeout),
+	}
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s knocked (waiting: %d)", h.roomID, client.userID, waiting)
+
+	client.Send(NewOutgoingMessage(TypeWaitingApproval, waitingPayload))
+	h.sendToAdmitters(NewOutgoingMessage(TypeKnock, knock))
+}
+
+// leaveLobby tira da sala de espera quem desistiu.
+// Retorna false se o cliente não estava esperando.
+func (h *RoomHub) leaveLobby(client *Client) bool {
+	h.mu.Lock()
+	if current, exists := h.lobby[client.userID]; !exists || current != client {
+		h.mu.Unlock()
+		return false
+	}
+	delete(h.lobby, client.userID)
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s stopped waiting", h.roomID, client.userID)
+
+	h.sendToAdmitters(NewOutgoingMessage(TypeKnockResolved, KnockResolvedPayload{
+		UserID:  client.userID,
+		Outcome: KnockCanceled,
+	}))
+	return true
+}
+
+// handleAdmitUser aprova a entrada de quem está esperando.
+func (h *RoomHub) handleAdmitUser(client *Client, payload json.RawMessage) {
+	if !h.can(client, room.PermAdmit) {
+		client.SendError("NOT_ALLOWED", "You are not allowed to let users in")
+		return
+	}
+
+	var admitPayload AdmitUserPayload
+	if err := json.Unmarshal(payload, &admitPayload); err != nil || admitPayload.UserID == "" {
+		client.SendError("INVALID_PAYLOAD", "Invalid admit payload")
+		return
+	}
+
+	h.mu.RLock()
+	knocker, exists := h.lobby[admitPayload.UserID]
+	h.mu.RUnlock()
+
+	if !exists {
+		client.SendError("KNOCK_NOT_FOUND", "User is not waiting to enter")
+		return
+	}
+
+	// Quem foi banido ou expulso enquanto esperava não entra
+	banned, err := h.globalHub.roomService.IsBanned(client.ctx, room.ID(h.roomID), user.ID(knocker.userID))
+	if err != nil {
+		log.Printf("Room %s: failed to check ban for user %s: %v", h.roomID, knocker.userID, err)
+		client.SendError("ADMIT_FAILED", "Failed to let the user in, try again")
+		return
+	}
+	if _, kicked := h.KickCooldown(knocker.userID); banned || kicked {
+		h.removeKnock(knocker, NewOutgoingMessage(TypeKnockDenied, KnockDeniedPayload{
+			RoomID: h.roomID,
+		}), websocket.StatusPolicyViolation, "entry denied")
+		client.SendError("CANNOT_ADMIT", "This user is banned or was kicked from the room")
+		return
+	}
+
+	h.mu.Lock()
+	// O pedido pode ter sido resolvido por outra pessoa nesse meio tempo
+	if current, exists := h.lobby[knocker.userID]; !exists || current != knocker {
+		h.mu.Unlock()
+		client.SendError("KNOCK_NOT_FOUND", "User is not waiting to enter")
+		return
+	}
+	delete(h.lobby, knocker.userID)
+	h.admitted[knocker.userID] = true
+	knocker.SetWaiting(false)
+	knocker.joinedAt = time.Now()
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s let in by %s", h.roomID, knocker.userID, client.userID)
+
+	h.sendToAdmitters(NewOutgoingMessage(TypeKnockResolved, KnockResolvedPayload{
+		UserID:      knocker.userID,
+		Outcome:     KnockAdmitted,
+		ModeratorID: client.userID,
+	}))
+
+	// Entra na sala pelo fluxo normal (recebe room_state)
+	if !h.Register(knocker) {
+		knocker.Disconnect(NewOutgoingMessage(TypeRoomClosed, RoomClosedPayload{
+			RoomID: h.roomID,
+		}), websocket.StatusTryAgainLater, "room unavailable")
+	}
+}
+
+// handleDenyUser recusa a entrada de quem está esperando.
+func (h *RoomHub) handleDenyUser(client *Client, payload json.RawMessage) {
+	if !h.can(client, room.PermAdmit) {
+		client.SendError("NOT_ALLOWED", "You are not allowed to deny entry")
+		return
+	}
+
+	var denyPayload DenyUserPayload
+	if err := json.Unmarshal(payload, &denyPayload); err != nil || denyPayload.UserID == "" {
+		client.SendError("INVALID_PAYLOAD", "Invalid deny payload")
+		return
+	}
+	reason := truncateReason(denyPayload.Reason)
+
+	h.mu.Lock()
+	knocker, exists := h.lobby[denyPayload.UserID]
+	if !exists {
+		h.mu.Unlock()
+		client.SendError("KNOCK_NOT_FOUND", "User is not waiting to enter")
+		return
+	}
+	delete(h.lobby, knocker.userID)
+	retryAfter := time.Now().Add(knockDeniedCooldown)
+	h.deniedUntil[knocker.userID] = retryAfter
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s denied entry by %s", h.roomID, knocker.userID, client.userID)
+
+	h.sendToAdmitters(NewOutgoingMessage(TypeKnockResolved, KnockResolvedPayload{
+		UserID:      knocker.userID,
+		Outcome:     KnockDenied,
+		ModeratorID: client.userID,
+	}))
+
+	knocker.Disconnect(NewOutgoingMessage(TypeKnockDenied, KnockDeniedPayload{
+		RoomID:     h.roomID,
+		Reason:     reason,
+		RetryAfter: &retryAfter,
+	}), websocket.StatusPolicyViolation, "entry denied")
+}
+
+// removeKnock tira um cliente da sala de espera, avisa quem pode aprovar
+// e fecha a conexão com uma última mensagem.
+// Retorna false se essa conexão não estava mais esperando.
+func (h *RoomHub) removeKnock(knocker *Client, msg *OutgoingMessage, status websocket.StatusCode, reason string) bool {
+	h.mu.Lock()
+	if current, exists := h.lobby[knocker.userID]; !exists || current != knocker {
+		h.mu.Unlock()
+		return false
+	}
+	delete(h.lobby, knocker.userID)
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s removed from the waiting room (%s)", h.roomID, knocker.userID, reason)
+
+	h.sendToAdmitters(NewOutgoingMessage(TypeKnockResolved, KnockResolvedPayload{
+		UserID:  knocker.userID,
+		Outcome: KnockRemoved,
+	}))
+	knocker.Disconnect(msg, status, reason)
+	return true
+}
+
+// expireKnocks desconecta quem esperou demais sem resposta.
+func (h *RoomHub) expireKnocks() {
+	now := time.Now()
+
+	h.mu.Lock()
+	var expired []*Client
+	for userID, c := range h.lobby {
+		if now.Sub(c.joinedAt) > knockTimeout {
+			delete(h.lobby, userID)
+			expired = append(expired, c)
+		}
+	}
+	for userID, until := range h.deniedUntil {
+		if now.After(until) {
+			delete(h.deniedUntil, userID)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, c := range expired {
+		log.Printf("Room %s: knock from user %s expired", h.roomID, c.userID)
+
+		h.sendToAdmitters(NewOutgoingMessage(TypeKnockResolved, KnockResolvedPayload{
+			UserID:  c.userID,
+			Outcome: KnockExpired,
+		}))
+		c.Disconnect(NewOutgoingMessage(TypeKnockDenied, KnockDeniedPayload{
+			RoomID: h.roomID,
+			Reason: "Nobody answered",
+		}), websocket.StatusTryAgainLater, "knock expired")
+	}
+}
+
+// pendingKnocks lista quem está esperando, dos mais antigos para os mais novos.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) pendingKnocks() []KnockPayload {
+	knocks := make([]KnockPayload, 0, len(h.lobby))
+	for _, c := range h.lobby {
+		knocks = append(knocks, knockInfo(c))
+	}
+	sort.Slice(knocks, func(i, j int) bool {
+		return knocks[i].KnockedAt.Before(knocks[j].KnockedAt)
+	})
+	return knocks
+}
+
+// sendToAdmitters envia uma mensagem só para quem pode aprovar entradas.
+func (h *RoomHub) sendToAdmitters(msg *OutgoingMessage) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, c := range h.clients {
+		if h.can(c, room.PermAdmit) {
+			c.Send(msg)
+		}
+	}
+}
+
+// LobbyCount retorna quantas pessoas estão esperando aprovação.
+func (h *RoomHub) LobbyCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.lobby)
+}
+
+// knockInfo monta o pedido de entrada de um cliente.
+func knockInfo(c *Client) KnockPayload {
+	return KnockPayload{
+		UserID:      c.userID,
+		DisplayName: c.displayName,
+		KnockedAt:   c.joinedAt,
+	}
+}
@@ -10,19 +10,52 @@ type MessageType string
 
 const (
 	// Servidor → Cliente
-	TypeRoomState   MessageType = "room_state"
-	TypeUserJoined  MessageType = "user_joined"
-	TypeUserLeft    MessageType = "user_left"
-	TypeSeatUpdated MessageType = "seat_updated"
-	TypeMediaState  MessageType = "media_state"
-	TypeMediaSync   MessageType = "media_sync"
-	TypeError       MessageType = "error"
+	TypeRoomState         MessageType = "room_state"
+	TypeUserJoined        MessageType = "user_joined"
+	TypeUserLeft          MessageType = "user_left"
+	TypeUserUpdated       MessageType = "user_updated"
+	TypeRoomUpdated       MessageType = "room_updated"
+	TypeSettingsUpdated   MessageType = "settings_updated"
+	TypeRoomClosed        MessageType = "room_closed"
+	TypeBanned            MessageType = "banned"
+	TypeKicked            MessageType = "kicked"
+	TypeModeration        MessageType = "moderation"
+	TypeRoleUpdated       MessageType = "role_updated"
+	TypeSeatUpdated       MessageType = "seat_updated"
+	TypeSeatSwapRequested MessageType = "seat_swap_requested"
+	TypeSeatSwapDeclined  MessageType = "seat_swap_declined"
+	TypeMediaState        MessageType = "media_state"
+	TypeMediaSync         MessageType = "media_sync"
+	TypeError             MessageType = "error"
+
+	// Servidor → Cliente (sala de espera)
+	TypeWaitingApproval MessageType = "waiting_approval" // Para quem pediu para entrar
+	TypeKnockDenied     MessageType = "knock_denied"     // Entrada recusada ou sem resposta
+	TypeKnock           MessageType = "knock"            // Para quem pode aprovar
+	TypeKnockResolved   MessageType = "knock_resolved"   // Para quem pode aprovar
+
+	// Servidor → Cliente (notificações do usuário)
+	TypeAchievementUnlocked MessageType = "achievement_unlocked"
+	TypeRoomTransferOffer   MessageType = "room_transfer_offer"
+	TypeEventReminder       MessageType = "event_reminder"
+
+	// Servidor → Cliente (conexão pessoal, /ws/me)
+	TypeDirectMessage      MessageType = "direct_message"
+	TypeDirectMessagesRead MessageType = "direct_messages_read"
 
 	// Cliente → Servidor
-	TypeChatMessage  MessageType = "chat_message"
-	TypeSelectSeat   MessageType = "select_seat"
-	TypeMediaControl MessageType = "media_control"
-	TypeAvatarAction MessageType = "avatar_action"
+	TypeChatMessage      MessageType = "chat_message"
+	TypeSelectSeat       MessageType = "select_seat"
+	TypeSeatSwapRequest  MessageType = "seat_swap_request"
+	TypeSeatSwapResponse MessageType = "seat_swap_response"
+	TypeMediaControl     MessageType = "media_control"
+	TypeAvatarAction     MessageType = "avatar_action"
+	TypeKickUser         MessageType = "kick_user"
+	TypeMuteUser         MessageType = "mute_user"
+	TypeUnmuteUser       MessageType = "unmute_user"
+	TypeAdmitUser        MessageType = "admit_user"
+	TypeDenyUser         MessageType = "deny_user"
+	TypeUpdateSettings   MessageType = "update_settings"
 )
 
 // IncomingMessage é a estrutura de mensagens recebidas do cliente.
@@ -51,33 +84,101 @@ func NewOutgoingMessage(msgType MessageType, payload interface{}) *OutgoingMessa
 
 // RoomStatePayload é o estado inicial da sala.
 type RoomStatePayload struct {
-	Room  RoomInfo    `json:"room"`
-	Users []UserInfo  `json:"users"`
-	Seats []SeatInfo  `json:"seats"`
-	Media *MediaState `json:"media,omitempty"`
+	Room     RoomInfo             `json:"room"`
+	Users    []UserInfo           `json:"users"`
+	Seats    []SeatInfo           `json:"seats"`
+	Layout   LayoutInfo           `json:"layout"`
+	Media    *MediaState          `json:"media,omitempty"`
+	Settings RoomSettingsInfo     `json:"settings"`
+	Messages []ChatMessagePayload `json:"messages"`         // Últimas mensagens do chat, das mais antigas para as mais recentes
+	Knocks   []KnockPayload       `json:"knocks,omitempty"` // Só para quem pode aprovar entradas
 }
 
 // RoomInfo são informações básicas da sala.
 type RoomInfo struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Theme    string `json:"theme"`
-	OwnerID  string `json:"owner_id"`
-	MaxSeats int    `json:"max_seats"`
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Theme          string `json:"theme"`
+	OwnerID        string `json:"owner_id"`
+	MaxSeats       int    `json:"max_seats"`
+	SpectatorSlots int    `json:"spectator_slots"`
+}
+
+// RoomUpdatedPayload é enviado quando os dados da sala mudam.
+type RoomUpdatedPayload struct {
+	Room RoomInfo `json:"room"`
+}
+
+// RoomSettingsInfo são as configurações da sala em vigor.
+type RoomSettingsInfo struct {
+	SlowModeSeconds int    `json:"slow_mode_seconds"`
+	ChatEnabled     bool   `json:"chat_enabled"`
+	LinksAllowed    bool   `json:"links_allowed"`
+	MediaControl    string `json:"media_control"` // owner, hosts ou everyone
+	GuestsAllowed   bool   `json:"guests_allowed"`
+}
+
+// SettingsUpdatedPayload é enviado quando as configurações da sala mudam.
+type SettingsUpdatedPayload struct {
+	Settings RoomSettingsInfo `json:"settings"`
+}
+
+// UpdateSettingsPayload é enviado pelo dono para alterar as configurações.
+// Campos omitidos mantêm o valor atual.
+type UpdateSettingsPayload struct {
+	SlowModeSeconds *int    `json:"slow_mode_seconds"`
+	ChatEnabled     *bool   `json:"chat_enabled"`
+	LinksAllowed    *bool   `json:"links_allowed"`
+	MediaControl    *string `json:"media_control"`
+	GuestsAllowed   *bool   `json:"guests_allowed"`
+}
+
+// RoomClosedPayload é enviado a todos antes de a sala ser fechada.
+type RoomClosedPayload struct {
+	RoomID string `json:"room_id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// BannedPayload é enviado ao usuário banido antes de desconectá-lo.
+type BannedPayload struct {
+	RoomID    string     `json:"room_id"`
+	Reason    string     `json:"reason,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // nil = permanente
+}
+
+// KickedPayload é enviado ao usuário expulso antes de desconectá-lo.
+type KickedPayload struct {
+	RoomID      string    `json:"room_id"`
+	Reason      string    `json:"reason,omitempty"`
+	RejoinAfter time.Time `json:"rejoin_after"`
 }
 
 // UserInfo são informações de um usuário na sala.
 type UserInfo struct {
 	ID          string `json:"id"`
 	DisplayName string `json:"display_name"`
+	Role        string `json:"role"`
 	SeatID      string `json:"seat_id,omitempty"`
+	Spectator   bool   `json:"spectator,omitempty"`
 }
 
 // SeatInfo são informações de um assento.
 type SeatInfo struct {
 	ID       string  `json:"id"`
 	Position int     `json:"position"`
+	Row      string  `json:"row"`
+	Column   int     `json:"column"`
+	Kind     string  `json:"kind"` // standard, accessible, vip
 	UserID   *string `json:"user_id,omitempty"`
+
+	ReservedFor *string    `json:"reserved_for,omitempty"` // Reservado pelo dono ou por convite
+	HeldUntil   *time.Time `json:"held_until,omitempty"`   // Guardado para quem caiu
+}
+
+// RoleUpdatedPayload é enviado quando o papel de um usuário muda.
+type RoleUpdatedPayload struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
 }
 
 // UserJoinedPayload é enviado quando alguém entra.
@@ -85,6 +186,12 @@ type UserJoinedPayload struct {
 	User UserInfo `json:"user"`
 }
 
+// UserUpdatedPayload é enviado quando o estado de um usuário muda
+// (ex: espectador promovido a participante).
+type UserUpdatedPayload struct {
+	User UserInfo `json:"user"`
+}
+
 // UserLeftPayload é enviado quando alguém sai.
 type UserLeftPayload struct {
 	UserID string `json:"user_id"`
@@ -99,6 +206,13 @@ type ChatMessagePayload struct {
 	CreatedAt   time.Time `json:"created_at,omitempty"`
 }
 
+// LayoutInfo é o formato da plateia: filas, colunas e corredores.
+type LayoutInfo struct {
+	Rows    int   `json:"rows"`
+	Columns int   `json:"columns"`
+	Aisles  []int `json:"aisles"` // Corredor depois dessas colunas
+}
+
 // SelectSeatPayload é para escolher assento.
 type SelectSeatPayload struct {
 	SeatID string `json:"seat_id"`
@@ -106,8 +220,35 @@ type SelectSeatPayload struct {
 
 // SeatUpdatedPayload é enviado quando um assento muda.
 type SeatUpdatedPayload struct {
-	SeatID string  `json:"seat_id"`
-	UserID *string `json:"user_id"` // nil = assento liberado
+	SeatID      string     `json:"seat_id"`
+	UserID      *string    `json:"user_id"` // nil = assento liberado
+	ReservedFor *string    `json:"reserved_for,omitempty"`
+	HeldUntil   *time.Time `json:"held_until,omitempty"` // Ocupante caiu; o lugar é dele até lá
+}
+
+// SeatSwapRequestPayload é para pedir a troca com quem está em um assento.
+type SeatSwapRequestPayload struct {
+	SeatID string `json:"seat_id"`
+}
+
+// SeatSwapRequestedPayload é enviado ao ocupante que recebeu o pedido de troca.
+type SeatSwapRequestedPayload struct {
+	FromUserID string    `json:"from_user_id"`
+	FromSeatID string    `json:"from_seat_id"`
+	SeatID     string    `json:"seat_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// SeatSwapResponsePayload é a resposta do ocupante a um pedido de troca.
+type SeatSwapResponsePayload struct {
+	UserID string `json:"user_id"` // Quem pediu a troca
+	Accept bool   `json:"accept"`
+}
+
+// SeatSwapDeclinedPayload avisa quem pediu que a troca foi recusada.
+type SeatSwapDeclinedPayload struct {
+	UserID string `json:"user_id"` // Ocupante que recusou
+	SeatID string `json:"seat_id"`
 }
 
 // ErrorPayload é enviado quando ocorre um erro.
@@ -116,6 +257,112 @@ type ErrorPayload struct {
 	Message string `json:"message"`
 }
 
+// NotificationPayload é uma notificação pessoal do usuário.
+// O tipo da mensagem indica o tipo da notificação (ex: achievement_unlocked).
+type NotificationPayload struct {
+	Title     string                 `json:"title"`
+	Body      string                 `json:"body,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// --- Moderation Payloads ---
+
+// ModerationAction define as ações de moderação.
+type ModerationAction string
+
+const (
+	ModerationKick   ModerationAction = "kick"
+	ModerationMute   ModerationAction = "mute"
+	ModerationUnmute ModerationAction = "unmute"
+)
+
+// KickUserPayload é enviado por um moderador para expulsar alguém.
+type KickUserPayload struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// MuteUserPayload é enviado por um moderador para silenciar alguém no chat.
+type MuteUserPayload struct {
+	UserID  string `json:"user_id"`
+	Minutes int    `json:"minutes"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// UnmuteUserPayload é enviado por um moderador para remover o silêncio.
+type UnmuteUserPayload struct {
+	UserID string `json:"user_id"`
+}
+
+// ModerationPayload é enviado para todos quando uma ação de moderação acontece.
+type ModerationPayload struct {
+	Action      ModerationAction `json:"action"`
+	UserID      string           `json:"user_id"`
+	ModeratorID string           `json:"moderator_id"`
+	Reason      string           `json:"reason,omitempty"`
+	Until       *time.Time       `json:"until,omitempty"` // Fim do mute ou do cooldown do kick
+}
+
+// --- Lobby Payloads ---
+
+// WaitingApprovalPayload é enviado a quem pediu para entrar em uma sala com aprovação.
+type WaitingApprovalPayload struct {
+	RoomID    string    `json:"room_id"`
+	RoomName  string    `json:"room_name"`
+	ExpiresAt time.Time `json:"expires_at"` // Sem resposta até lá, o pedido expira
+}
+
+// KnockPayload é um pedido de entrada, enviado a quem pode aprovar.
+type KnockPayload struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	KnockedAt   time.Time `json:"knocked_at"`
+}
+
+// KnockResolvedPayload avisa quem pode aprovar que um pedido saiu da fila.
+type KnockResolvedPayload struct {
+	UserID      string `json:"user_id"`
+	Outcome     string `json:"outcome"` // admitted, denied, canceled, expired, removed
+	ModeratorID string `json:"moderator_id,omitempty"`
+}
+
+// KnockDeniedPayload é enviado a quem teve a entrada recusada antes de desconectá-lo.
+type KnockDeniedPayload struct {
+	RoomID     string     `json:"room_id"`
+	Reason     string     `json:"reason,omitempty"`
+	RetryAfter *time.Time `json:"retry_after,omitempty"`
+}
+
+// AdmitUserPayload é enviado por quem pode aprovar para deixar alguém entrar.
+type AdmitUserPayload struct {
+	UserID string `json:"user_id"`
+}
+
+// DenyUserPayload é enviado por quem pode aprovar para recusar a entrada.
+type DenyUserPayload struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// --- Direct Message Payloads ---
+
+// DirectMessagePayload é uma mensagem direta entregue na conexão pessoal.
+type DirectMessagePayload struct {
+	ID         string    `json:"id"`
+	FromUserID string    `json:"from_user_id"`
+	ToUserID   string    `json:"to_user_id"`
+	Content    string    `json:"content"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// DirectMessagesReadPayload avisa que as mensagens de SenderID foram lidas por ReaderID.
+type DirectMessagesReadPayload struct {
+	SenderID string    `json:"sender_id"`
+	ReaderID string    `json:"reader_id"`
+	ReadAt   time.Time `json:"read_at"`
+}
+
 // --- Media Payloads ---
 
 // MediaState representa o estado atual do player.
@@ -0,0 +1,243 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/coder/websocket"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+const (
+	// Tempo que um usuário expulso precisa esperar para voltar
+	kickCooldown = 2 * time.Minute
+
+	// Limites do mute (em minutos)
+	defaultMuteMinutes = 5
+	maxMuteMinutes     = 24 * 60
+
+	// Tamanho máximo do motivo de uma ação de moderação
+	maxModerationReasonLength = 200
+)
+
+// canModerate verifica se o cliente pode expulsar e silenciar na sala.
+func (h *RoomHub) canModerate(client *Client) bool {
+	return h.can(client, room.PermKick)
+}
+
+// moderationTarget valida o alvo de uma ação de moderação.
+// Retorna o cliente alvo conectado ou envia o erro ao moderador.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) moderationTarget(moderator *Client, targetID string) (*Client, bool) {
+	if targetID == "" {
+		moderator.SendError("INVALID_PAYLOAD", "User ID is required")
+		return nil, false
+	}
+
+	if targetID == moderator.userID {
+		moderator.SendError("INVALID_TARGET", "You cannot moderate yourself")
+		return nil, false
+	}
+
+	target, exists := h.clients[targetID]
+	if !exists {
+		moderator.SendError("USER_NOT_IN_ROOM", "User is not in the room")
+		return nil, false
+	}
+
+	// Só é possível moderar quem está abaixo na hierarquia
+	if !moderator.GetRole().Outranks(target.GetRole()) {
+		moderator.SendError("INVALID_TARGET", "You cannot moderate a user with an equal or higher role")
+		return nil, false
+	}
+
+	return target, true
+}
+
+// handleKickUser expulsa um usuário da sala.
+// O usuário é desconectado e só pode voltar depois do cooldown.
+func (h *RoomHub) handleKickUser(client *Client, payload json.RawMessage) {
+	if !h.canModerate(client) {
+		client.SendError("NOT_MODERATOR", "Only moderators can kick users")
+		return
+	}
+
+	var kickPayload KickUserPayload
+	if err := json.Unmarshal(payload, &kickPayload); err != nil {
+		client.SendError("INVALID_PAYLOAD", "Invalid kick payload")
+		return
+	}
+	reason := truncateReason(kickPayload.Reason)
+
+	h.mu.Lock()
+	target, ok := h.moderationTarget(client, kickPayload.UserID)
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+
+	rejoinAfter := time.Now().Add(kickCooldown)
+	h.kickedUntil[target.userID] = rejoinAfter
+	delete(h.admitted, target.userID) // Em salas com aprovação, precisa pedir de novo
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s kicked by %s", h.roomID, target.userID, client.userID)
+
+	h.broadcastModeration(ModerationPayload{
+		Action:      ModerationKick,
+		UserID:      target.userID,
+		ModeratorID: client.userID,
+		Reason:      reason,
+		Until:       &rejoinAfter,
+	})
+
+	target.ForfeitSeat()
+	target.Disconnect(NewOutgoingMessage(TypeKicked, KickedPayload{
+		RoomID:      h.roomID,
+		Reason:      reason,
+		RejoinAfter: rejoinAfter,
+	}), websocket.StatusPolicyViolation, "kicked from room")
+
+	h.audit(client, room.AuditKick, target.userID, reason, nil)
+}
+
+// handleMuteUser silencia um usuário no chat por alguns minutos.
+func (h *RoomHub) handleMuteUser(client *Client, payload json.RawMessage) {
+	if !h.canModerate(client) {
+		client.SendError("NOT_MODERATOR", "Only moderators can mute users")
+		return
+	}
+
+	var mutePayload MuteUserPayload
+	if err := json.Unmarshal(payload, &mutePayload); err != nil {
+		client.SendError("INVALID_PAYLOAD", "Invalid mute payload")
+		return
+	}
+
+	minutes := mutePayload.Minutes
+	if minutes == 0 {
+		minutes = defaultMuteMinutes
+	}
+	if minutes < 0 || minutes > maxMuteMinutes {
+		client.SendError("INVALID_DURATION", "Mute duration must be between 1 and 1440 minutes")
+		return
+	}
+
+	h.mu.Lock()
+	target, ok := h.moderationTarget(client, mutePayload.UserID)
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+
+	until := time.Now().Add(time.Duration(minutes) * time.Minute)
+	h.mutedUntil[target.userID] = until
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s muted by %s for %d minutes", h.roomID, target.userID, client.userID, minutes)
+
+	reason := truncateReason(mutePayload.Reason)
+	h.broadcastModeration(ModerationPayload{
+		Action:      ModerationMute,
+		UserID:      target.userID,
+		ModeratorID: client.userID,
+		Reason:      reason,
+		Until:       &until,
+	})
+	h.audit(client, room.AuditMute, target.userID, reason, map[string]string{
+		"minutes": strconv.Itoa(minutes),
+	})
+}
+
+// handleUnmuteUser remove o silêncio de um usuário.
+func (h *RoomHub) handleUnmuteUser(client *Client, payload json.RawMessage) {
+	if !h.canModerate(client) {
+		client.SendError("NOT_MODERATOR", "Only moderators can unmute users")
+		return
+	}
+
+	var unmutePayload UnmuteUserPayload
+	if err := json.Unmarshal(payload, &unmutePayload); err != nil || unmutePayload.UserID == "" {
+		client.SendError("INVALID_PAYLOAD", "Invalid unmute payload")
+		return
+	}
+
+	h.mu.Lock()
+	_, muted := h.mutedUntil[unmutePayload.UserID]
+	delete(h.mutedUntil, unmutePayload.UserID)
+	h.mu.Unlock()
+
+	if !muted {
+		client.SendError("NOT_MUTED", "User is not muted")
+		return
+	}
+
+	log.Printf("Room %s: user %s unmuted by %s", h.roomID, unmutePayload.UserID, client.userID)
+
+	h.broadcastModeration(ModerationPayload{
+		Action:      ModerationUnmute,
+		UserID:      unmutePayload.UserID,
+		ModeratorID: client.userID,
+	})
+	h.audit(client, room.AuditUnmute, unmutePayload.UserID, "", nil)
+}
+
+// isMuted verifica se o usuário está silenciado.
+// Mutes expirados são removidos. Deve ser chamado com o mutex travado.
+func (h *RoomHub) isMuted(userID string) bool {
+	until, exists := h.mutedUntil[userID]
+	if !exists {
+		return false
+	}
+
+	if time.Now().After(until) {
+		delete(h.mutedUntil, userID)
+		return false
+	}
+
+	return true
+}
+
+// KickCooldown informa até quando o usuário expulso não pode voltar.
+func (h *RoomHub) KickCooldown(userID string) (time.Time, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	until, exists := h.kickedUntil[userID]
+	if !exists || time.Now().After(until) {
+		return time.Time{}, false
+	}
+
+	return until, true
+}
+
+// broadcastModeration avisa todos sobre uma ação de moderação.
+func (h *RoomHub) broadcastModeration(payload ModerationPayload) {
+	h.enqueue(NewOutgoingMessage(TypeModeration, payload))
+}
+
+// audit grava uma ação de moderação no log de auditoria da sala.
+// A ação em si já aconteceu: uma falha só é registrada no log do servidor.
+func (h *RoomHub) audit(moderator *Client, action room.AuditAction, targetID, reason string, details map[string]string) {
+	entry := room.NewAuditEntry(room.ID(h.roomID), action, user.ID(moderator.userID), user.ID(targetID), reason)
+	for key, value := range details {
+		entry.Details[key] = value
+	}
+
+	if err := h.globalHub.roomService.RecordAudit(moderator.ctx, entry); err != nil {
+		log.Printf("Room %s: failed to record %s by %s in audit log: %v", h.roomID, action, moderator.userID, err)
+	}
+}
+
+// truncateReason limita o tamanho do motivo de uma ação de moderação.
+// Corta por caracteres (e não bytes) para não gerar UTF-8 inválido.
+func truncateReason(reason string) string {
+	if utf8.RuneCountInString(reason) > maxModerationReasonLength {
+		return string([]rune(reason)[:maxModerationReasonLength])
+	}
+	return reason
+}
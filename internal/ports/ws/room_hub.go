@@ -2,11 +2,17 @@ package ws
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"sync"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/coder/websocket"
+	"github.com/vinib1903/cineus-api/internal/app/events"
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
 )
 
 // RoomHub gerencia os clientes de uma sala.
@@ -20,20 +26,71 @@ type RoomHub struct {
 	ownerID   string
 	maxSeats  int
 
+	// Disposição dos assentos (fixa enquanto a sala estiver aberta;
+	// uma troca de tema vale a partir da próxima abertura)
+	layout room.SeatLayout
+
+	// Vagas extras para espectadores (além dos assentos)
+	spectatorSlots int
+
+	// Tempo que a sala vazia continua ativa esperando reconexões
+	idleTimeout time.Duration
+
 	// Clientes conectados: userID -> Client
 	clients map[string]*Client
 
-	// Assentos: seatID -> userID
+	// Assentos: seatID -> userID (inclui assentos guardados)
 	seats map[string]string
 
+	// Assentos guardados para quem caiu: seatID -> reserva temporária
+	holds map[string]seatHold
+
+	// Tempo que o assento de quem caiu fica guardado
+	seatHoldGrace time.Duration
+
+	// Assentos reservados pelo dono ou por convite: seatID -> userID
+	reserved map[string]string
+
+	// Pedidos de troca de assento pendentes: userID de quem pediu -> pedido
+	swaps map[string]seatSwap
+
 	// Estado do player de mídia
 	mediaState *MediaState
 
+	// Configurações da sala (modo lento, chat, links, mídia, convidados)
+	settings room.Settings
+
+	// Última mensagem de chat de cada usuário (modo lento): userID -> horário
+	lastChatAt map[string]time.Time
+
+	// Últimas mensagens do chat, enviadas a quem entra
+	history []ChatMessagePayload
+
+	// Indica se esta sessão já contou como festa hospedada pelo dono
+	partyHosted bool
+
+	// Moderação: userID -> fim do mute / fim do cooldown do kick
+	mutedUntil  map[string]time.Time
+	kickedUntil map[string]time.Time
+
+	// Sala de espera (salas com aprovação): userID -> cliente aguardando
+	lobby map[string]*Client
+
+	// Aprovados nesta sessão (reconexões não precisam de nova aprovação)
+	admitted map[string]bool
+
+	// Entrada recusada: userID -> quando pode pedir de novo
+	deniedUntil map[string]time.Time
+
 	// Canais de comunicação
 	register   chan *Client
+	knock      chan *Client
 	unregister chan *Client
 	broadcast  chan *OutgoingMessage
 
+	// Fechado quando o loop da sala termina
+	done chan struct{}
+
 	// Mutex para proteger maps e mediaState
 	mu sync.RWMutex
 
@@ -42,29 +99,43 @@ type RoomHub struct {
 }
 
 // NewRoomHub cria um novo hub de sala.
-func NewRoomHub(globalHub *Hub, roomID, roomName, roomTheme, ownerID string, maxSeats int) *RoomHub {
+func NewRoomHub(globalHub *Hub, roomID, roomName, roomTheme, ownerID string, maxSeats, spectatorSlots int, idleTimeout, seatHoldGrace time.Duration) *RoomHub {
+	layout := room.NewSeatLayout(room.Theme(roomTheme), maxSeats)
+
 	hub := &RoomHub{
-		roomID:     roomID,
-		roomName:   roomName,
-		roomTheme:  roomTheme,
-		ownerID:    ownerID,
-		maxSeats:   maxSeats,
-		clients:    make(map[string]*Client),
-		seats:      make(map[string]string),
-		mediaState: nil, // Sem vídeo inicialmente
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		broadcast:  make(chan *OutgoingMessage, 256),
-		globalHub:  globalHub,
+		roomID:         roomID,
+		roomName:       roomName,
+		roomTheme:      roomTheme,
+		ownerID:        ownerID,
+		maxSeats:       len(layout.Seats),
+		layout:         layout,
+		spectatorSlots: spectatorSlots,
+		idleTimeout:    idleTimeout,
+		clients:        make(map[string]*Client),
+		seats:          make(map[string]string),
+		holds:          make(map[string]seatHold),
+		seatHoldGrace:  seatHoldGrace,
+		reserved:       make(map[string]string),
+		swaps:          make(map[string]seatSwap),
+		mediaState:     nil, // Sem vídeo inicialmente
+		settings:       room.DefaultSettings(),
+		lastChatAt:     make(map[string]time.Time),
+		mutedUntil:     make(map[string]time.Time),
+		kickedUntil:    make(map[string]time.Time),
+		lobby:          make(map[string]*Client),
+		admitted:       make(map[string]bool),
+		deniedUntil:    make(map[string]time.Time),
+		register:       make(chan *Client),
+		knock:          make(chan *Client),
+		unregister:     make(chan *Client),
+		broadcast:      make(chan *OutgoingMessage, 256),
+		done:           make(chan struct{}),
+		globalHub:      globalHub,
 	}
 
 	// Inicializar assentos vazios
-	for i := 1; i <= maxSeats; i++ {
-		seatID := string(rune('A'-1+i/10+1)) + string(rune('0'+i%10))
-		if i <= 9 {
-			seatID = "A" + string(rune('0'+i))
-		}
-		hub.seats[seatID] = ""
+	for _, seat := range layout.Seats {
+		hub.seats[seat.ID] = ""
 	}
 
 	return hub
@@ -72,71 +143,179 @@ func NewRoomHub(globalHub *Hub, roomID, roomName, roomTheme, ownerID string, max
 
 // Run inicia o loop principal do hub.
 func (h *RoomHub) Run() {
+	defer close(h.done)
+
+	// Ativo apenas enquanto a sala está vazia
+	var idle <-chan time.Time
+
+	// Limpeza de assentos guardados e pedidos de troca vencidos
+	sweep := time.NewTicker(seatSweepInterval)
+	defer sweep.Stop()
+
 	for {
 		select {
 		case client := <-h.register:
 			h.handleRegister(client)
+			idle = nil
+
+		case client := <-h.knock:
+			h.handleKnock(client)
+			idle = nil
 
 		case client := <-h.unregister:
 			h.handleUnregister(client)
+			if idle == nil && h.ClientCount() == 0 && h.LobbyCount() == 0 {
+				log.Printf("Room %s: empty, keeping warm for %v", h.roomID, h.idleTimeout)
+				idle = time.After(h.idleTimeout)
+			}
 
 		case message := <-h.broadcast:
 			h.handleBroadcast(message)
+
+		case <-sweep.C:
+			h.expireSeats()
+			h.expireKnocks()
+			h.expireChatLimits()
+
+		case <-idle:
+			// Só o próprio loop registra clientes, então a sala continua vazia
+			log.Printf("Room %s: idle timeout, shutting down", h.roomID)
+			h.globalHub.removeRoom(h)
+			return
 		}
 	}
 }
 
+// Register entra na fila de registro da sala.
+// Retorna false se a sala já foi encerrada (o cliente deve buscar a sala de novo).
+func (h *RoomHub) Register(client *Client) bool {
+	select {
+	case h.register <- client:
+		return true
+	case <-h.done:
+		return false
+	}
+}
+
+// Unregister entra na fila de saída da sala.
+func (h *RoomHub) Unregister(client *Client) {
+	select {
+	case h.unregister <- client:
+	case <-h.done:
+	}
+}
+
+// enqueue coloca uma mensagem na fila de broadcast.
+// Descartada se a sala já foi encerrada.
+func (h *RoomHub) enqueue(msg *OutgoingMessage) {
+	select {
+	case h.broadcast <- msg:
+	case <-h.done:
+	}
+}
+
 // handleRegister adiciona um cliente à sala.
 func (h *RoomHub) handleRegister(client *Client) {
 	h.mu.Lock()
 
 	// Verificar se usuário já está na sala
-	if existingClient, exists := h.clients[client.userID]; exists {
+	existingClient, reconnecting := h.clients[client.userID]
+
+	// Verificar se a sala está lotada (assentos + espectadores)
+	if !reconnecting && len(h.clients) >= h.capacity() {
+		h.mu.Unlock()
+		log.Printf("Room %s: full, rejecting user %s", h.roomID, client.userID)
+		client.Disconnect(NewOutgoingMessage(TypeError, ErrorPayload{
+			Code:    "ROOM_FULL",
+			Message: "Room is full",
+		}), websocket.StatusTryAgainLater, "room is full")
+		return
+	}
+
+	var seatChanges []SeatUpdatedPayload
+	if reconnecting {
+		// A nova conexão herda o lugar da antiga
+		client.SetSpectator(existingClient.IsSpectator())
+		client.SetSeatID(existingClient.GetSeatID())
 		existingClient.Close()
+	} else {
+		// Quem chega com todos os lugares ocupados entra como espectador
+		// (assentos guardados para quem caiu também contam)
+		held := h.heldSeatOf(client.userID) != ""
+		client.SetSpectator(!held && h.participantCount(client.userID)+h.heldCount(client.userID) >= h.maxSeats)
+
+		// Voltar ao assento guardado ou ocupar o reservado
+		seatChanges = h.seatStates(h.restoreSeat(client)...)
 	}
 
 	h.clients[client.userID] = client
+	clientCount := len(h.clients)
+	hostingParty := h.checkPartyHosted()
+	ownerID := h.ownerID
 	h.mu.Unlock()
 
-	log.Printf("Room %s: user %s joined (total: %d)", h.roomID, client.userID, len(h.clients))
+	log.Printf("Room %s: user %s joined (total: %d)", h.roomID, client.userID, clientCount)
+
+	if hostingParty {
+		h.publish(events.New(events.TypePartyHosted, user.ID(ownerID), room.ID(h.roomID)))
+	}
 
 	// Enviar estado inicial
 	h.sendRoomState(client)
 
 	// Notificar outros
 	h.broadcastUserJoined(client)
+	h.broadcastSeatUpdated(seatChanges...)
 }
 
-// handleUnregister remove um cliente da sala.
+// handleUnregister remove um cliente da sala (ou da sala de espera).
 func (h *RoomHub) handleUnregister(client *Client) {
+	if h.leaveLobby(client) {
+		return
+	}
+
 	h.mu.Lock()
 
-	if _, exists := h.clients[client.userID]; !exists {
+	// Ignorar conexões antigas já substituídas por uma reconexão
+	if current, exists := h.clients[client.userID]; !exists || current != client {
 		h.mu.Unlock()
 		return
 	}
 
-	// Liberar assento
-	seatID := client.GetSeatID()
-	if seatID != "" {
-		h.seats[seatID] = ""
-	}
+	// Liberar ou guardar o assento
+	seatID, held := h.vacateSeat(client)
+	h.cancelSwaps(client.userID)
 
 	delete(h.clients, client.userID)
 	clientCount := len(h.clients)
+
+	// Um lugar foi liberado: promover o espectador mais antigo
+	// (se o assento ficou guardado, a promoção espera o prazo acabar)
+	var promoted *Client
+	if !client.IsSpectator() && !held {
+		promoted = h.promoteSpectator()
+	}
+
+	var seatChanges []SeatUpdatedPayload
+	if seatID != "" {
+		seatChanges = h.seatStates(seatID)
+	}
 	h.mu.Unlock()
 
 	log.Printf("Room %s: user %s left (total: %d)", h.roomID, client.userID, clientCount)
 
-	h.broadcastUserLeft(client.userID)
+	watchEvent := events.New(events.TypeWatchSession, user.ID(client.userID), room.ID(h.roomID))
+	watchEvent.Duration = time.Since(client.joinedAt)
+	h.publish(watchEvent)
 
-	if seatID != "" {
-		h.broadcastSeatUpdated(seatID, nil)
-	}
+	h.broadcastUserLeft(client.userID)
+	h.broadcastSeatUpdated(seatChanges...)
 
-	if clientCount == 0 {
-		log.Printf("Room %s: empty, removing from global hub", h.roomID)
-		h.globalHub.removeRoom(h.roomID)
+	if promoted != nil {
+		log.Printf("Room %s: spectator %s promoted to participant", h.roomID, promoted.userID)
+		h.enqueue(NewOutgoingMessage(TypeUserUpdated, UserUpdatedPayload{
+			User: userInfo(promoted),
+		}))
 	}
 }
 
@@ -152,6 +331,12 @@ func (h *RoomHub) handleBroadcast(message *OutgoingMessage) {
 
 // handleMessage processa uma mensagem recebida de um cliente.
 func (h *RoomHub) handleMessage(client *Client, msg *IncomingMessage) {
+	// Quem está na sala de espera ainda não participa
+	if client.IsWaiting() {
+		client.SendError("WAITING_APPROVAL", "Wait until someone lets you in")
+		return
+	}
+
 	switch msg.Type {
 	case TypeChatMessage:
 		h.handleChatMessage(client, msg.Payload)
@@ -159,9 +344,33 @@ func (h *RoomHub) handleMessage(client *Client, msg *IncomingMessage) {
 	case TypeSelectSeat:
 		h.handleSelectSeat(client, msg.Payload)
 
+	case TypeSeatSwapRequest:
+		h.handleSeatSwapRequest(client, msg.Payload)
+
+	case TypeSeatSwapResponse:
+		h.handleSeatSwapResponse(client, msg.Payload)
+
 	case TypeMediaControl:
 		h.handleMediaControl(client, msg.Payload)
 
+	case TypeKickUser:
+		h.handleKickUser(client, msg.Payload)
+
+	case TypeMuteUser:
+		h.handleMuteUser(client, msg.Payload)
+
+	case TypeUnmuteUser:
+		h.handleUnmuteUser(client, msg.Payload)
+
+	case TypeAdmitUser:
+		h.handleAdmitUser(client, msg.Payload)
+
+	case TypeDenyUser:
+		h.handleDenyUser(client, msg.Payload)
+
+	case TypeUpdateSettings:
+		h.handleUpdateSettings(client, msg.Payload)
+
 	default:
 		client.SendError("UNKNOWN_TYPE", "Unknown message type")
 	}
@@ -185,15 +394,47 @@ func (h *RoomHub) handleChatMessage(client *Client, payload json.RawMessage) {
 		return
 	}
 
-	broadcastPayload := ChatMessagePayload{
-		ID:          uuid.New().String(),
-		UserID:      client.userID,
-		DisplayName: client.displayName,
-		Content:     chatPayload.Content,
-		CreatedAt:   time.Now(),
+	h.mu.Lock()
+	muted := h.isMuted(client.userID)
+	var limitCode, limitMessage string
+	if !muted {
+		limitCode, limitMessage = h.checkChatLimits(client, chatPayload.Content)
+	}
+	h.mu.Unlock()
+
+	if muted {
+		client.SendError("MUTED", "You are muted in this room")
+		return
+	}
+
+	// Chat desligado, link proibido ou modo lento
+	if limitCode != "" {
+		client.SendError(limitCode, limitMessage)
+		return
+	}
+
+	// Gravar antes de enviar: quem entrar depois recebe a mensagem no histórico
+	msg, err := h.globalHub.chat.SendRoomMessage(client.ctx, room.ID(h.roomID), user.ID(client.userID), chatPayload.Content)
+	if err != nil {
+		if errors.Is(err, chat.ErrMessageEmpty) {
+			client.SendError("EMPTY_MESSAGE", "Message content cannot be empty")
+			return
+		}
+		log.Printf("Room %s: failed to save message from %s: %v", h.roomID, client.userID, err)
+		client.SendError("MESSAGE_NOT_SENT", "Failed to send message, try again")
+		return
 	}
 
-	h.broadcast <- NewOutgoingMessage(TypeChatMessage, broadcastPayload)
+	broadcastPayload := chatMessageInfo(msg)
+	broadcastPayload.DisplayName = client.displayName
+
+	h.mu.Lock()
+	h.rememberMessage(broadcastPayload)
+	h.mu.Unlock()
+
+	h.enqueue(NewOutgoingMessage(TypeChatMessage, broadcastPayload))
+
+	h.publish(events.New(events.TypeChatMessageSent, user.ID(client.userID), room.ID(h.roomID)))
 }
 
 // handleSelectSeat processa a seleção de assento.
@@ -204,38 +445,45 @@ func (h *RoomHub) handleSelectSeat(client *Client, payload json.RawMessage) {
 		return
 	}
 
+	if client.IsSpectator() {
+		client.SendError("SPECTATOR", "Spectators cannot take seats")
+		return
+	}
+
+	seatPayload.SeatID = room.NormalizeSeatID(seatPayload.SeatID)
+
 	h.mu.Lock()
-	defer h.mu.Unlock()
 
 	currentOccupant, exists := h.seats[seatPayload.SeatID]
 	if !exists {
+		h.mu.Unlock()
 		client.SendError("INVALID_SEAT", "Seat does not exist")
 		return
 	}
 
 	if currentOccupant != "" && currentOccupant != client.userID {
+		h.mu.Unlock()
 		client.SendError("SEAT_OCCUPIED", "Seat is already occupied")
 		return
 	}
 
-	oldSeatID := client.GetSeatID()
-	if oldSeatID != "" && oldSeatID != seatPayload.SeatID {
-		h.seats[oldSeatID] = ""
-		go h.broadcastSeatUpdated(oldSeatID, nil)
+	if reservedFor, ok := h.reserved[seatPayload.SeatID]; ok && reservedFor != client.userID {
+		h.mu.Unlock()
+		client.SendError("SEAT_RESERVED", "Seat is reserved for another user")
+		return
 	}
 
-	h.seats[seatPayload.SeatID] = client.userID
-	client.SetSeatID(seatPayload.SeatID)
+	seatChanges := h.seatStates(h.seatClient(client, seatPayload.SeatID)...)
+	h.mu.Unlock()
 
-	userID := client.userID
-	go h.broadcastSeatUpdated(seatPayload.SeatID, &userID)
+	h.broadcastSeatUpdated(seatChanges...)
 }
 
 // handleMediaControl processa comandos de controle de mídia.
 func (h *RoomHub) handleMediaControl(client *Client, payload json.RawMessage) {
-	// Apenas o host pode controlar a mídia
-	if client.userID != h.ownerID {
-		client.SendError("NOT_HOST", "Only the room owner can control media")
+	// Quem pode controlar a mídia depende das configurações da sala
+	if !h.canControlMedia(client) {
+		client.SendError("NOT_ALLOWED", "You are not allowed to control media")
 		return
 	}
 
@@ -301,10 +549,228 @@ func (h *RoomHub) handleMediaControl(client *Client, payload json.RawMessage) {
 	log.Printf("Room %s: media %s by %s", h.roomID, controlPayload.Action, client.userID)
 
 	// Broadcast do novo estado para todos
-	h.broadcast <- NewOutgoingMessage(TypeMediaState, MediaStatePayload{
+	h.enqueue(NewOutgoingMessage(TypeMediaState, MediaStatePayload{
 		Media:     stateCopy,
 		UpdatedBy: client.userID,
-	})
+	}))
+}
+
+// LoadMedia substitui o vídeo atual (ex: início de uma sessão agendada)
+// e avisa todos os clientes.
+func (h *RoomHub) LoadMedia(media MediaState) {
+	h.mu.Lock()
+	h.mediaState = &media
+	h.mu.Unlock()
+
+	log.Printf("Room %s: media loaded (%s)", h.roomID, media.VideoURL)
+
+	h.enqueue(NewOutgoingMessage(TypeMediaState, MediaStatePayload{
+		Media: media,
+	}))
+}
+
+// UpdateInfo altera nome e tema da sala e avisa todos os clientes.
+func (h *RoomHub) UpdateInfo(name, theme string) {
+	h.mu.Lock()
+	h.roomName = name
+	h.roomTheme = theme
+	info := h.roomInfo()
+	h.mu.Unlock()
+
+	log.Printf("Room %s: updated (name: %s, theme: %s)", h.roomID, name, theme)
+
+	h.enqueue(NewOutgoingMessage(TypeRoomUpdated, RoomUpdatedPayload{
+		Room: info,
+	}))
+}
+
+// can verifica se o cliente tem a permissão na sala.
+func (h *RoomHub) can(client *Client, perm room.Permission) bool {
+	return client.GetRole().Can(perm)
+}
+
+// SetUserRole altera o papel de um usuário conectado e avisa todos.
+func (h *RoomHub) SetUserRole(userID string, role room.Role) {
+	h.mu.RLock()
+	client, exists := h.clients[userID]
+	h.mu.RUnlock()
+
+	if !exists {
+		return
+	}
+
+	client.SetRole(role)
+	log.Printf("Room %s: user %s is now %s", h.roomID, userID, role)
+
+	h.enqueue(NewOutgoingMessage(TypeRoleUpdated, RoleUpdatedPayload{
+		UserID: userID,
+		Role:   string(role),
+	}))
+}
+
+// TransferOwnership passa a posse da sala para outro usuário.
+// O antigo dono vira membro e todos recebem a sala atualizada.
+func (h *RoomHub) TransferOwnership(newOwnerID string) {
+	h.mu.Lock()
+	previousOwnerID := h.ownerID
+	h.ownerID = newOwnerID
+	info := h.roomInfo()
+	h.mu.Unlock()
+
+	log.Printf("Room %s: ownership transferred from %s to %s", h.roomID, previousOwnerID, newOwnerID)
+
+	h.SetUserRole(previousOwnerID, room.RoleMember)
+	h.SetUserRole(newOwnerID, room.RoleOwner)
+
+	h.enqueue(NewOutgoingMessage(TypeRoomUpdated, RoomUpdatedPayload{
+		Room: info,
+	}))
+}
+
+// DisconnectUser envia uma última mensagem ao usuário e fecha sua conexão.
+// A saída da sala segue o fluxo normal (unregister), sem guardar o assento.
+// Quem está na sala de espera também é tirado dela (ex: banido enquanto esperava).
+func (h *RoomHub) DisconnectUser(userID string, msg *OutgoingMessage, status websocket.StatusCode, reason string) bool {
+	h.mu.RLock()
+	client, exists := h.clients[userID]
+	knocker, waiting := h.lobby[userID]
+	h.mu.RUnlock()
+
+	removed := waiting && h.removeKnock(knocker, msg, status, reason)
+	if !exists {
+		return removed
+	}
+
+	log.Printf("Room %s: disconnecting user %s (%s)", h.roomID, userID, reason)
+	client.ForfeitSeat()
+	client.Disconnect(msg, status, reason)
+	return true
+}
+
+// DisconnectAll envia uma última mensagem a todos e fecha suas conexões.
+// Inclui quem está na sala de espera.
+func (h *RoomHub) DisconnectAll(msg *OutgoingMessage, status websocket.StatusCode, reason string) {
+	h.mu.Lock()
+	clients := make([]*Client, 0, len(h.clients)+len(h.lobby))
+	for _, c := range h.clients {
+		clients = append(clients, c)
+	}
+	for userID, c := range h.lobby {
+		delete(h.lobby, userID)
+		clients = append(clients, c)
+	}
+	h.mu.Unlock()
+
+	log.Printf("Room %s: disconnecting all %d clients (%s)", h.roomID, len(clients), reason)
+
+	for _, c := range clients {
+		c.Disconnect(msg, status, reason)
+	}
+}
+
+// ClientCount retorna o número de clientes conectados.
+func (h *RoomHub) ClientCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients)
+}
+
+// Activity retorna a ocupação atual e o vídeo carregado.
+func (h *RoomHub) Activity() approom.RoomActivity {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	activity := approom.RoomActivity{Viewers: len(h.clients)}
+	if h.mediaState != nil {
+		activity.NowPlaying = &approom.NowPlaying{
+			VideoURL:   h.mediaState.VideoURL,
+			VideoTitle: h.mediaState.VideoTitle,
+			IsPlaying:  h.mediaState.IsPlaying,
+		}
+	}
+	return activity
+}
+
+// roomInfo monta as informações básicas da sala.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) roomInfo() RoomInfo {
+	return RoomInfo{
+		ID:             h.roomID,
+		Name:           h.roomName,
+		Theme:          h.roomTheme,
+		OwnerID:        h.ownerID,
+		MaxSeats:       h.maxSeats,
+		SpectatorSlots: h.spectatorSlots,
+	}
+}
+
+// layoutInfo monta o formato do layout de assentos.
+func layoutInfo(l room.SeatLayout) LayoutInfo {
+	aisles := l.Aisles
+	if aisles == nil {
+		aisles = []int{}
+	}
+	return LayoutInfo{
+		Rows:    l.Rows,
+		Columns: l.Columns,
+		Aisles:  aisles,
+	}
+}
+
+// userInfo monta as informações públicas de um cliente.
+func userInfo(c *Client) UserInfo {
+	return UserInfo{
+		ID:          c.userID,
+		DisplayName: c.displayName,
+		Role:        string(c.GetRole()),
+		SeatID:      c.GetSeatID(),
+		Spectator:   c.IsSpectator(),
+	}
+}
+
+// capacity retorna o número máximo de conexões da sala.
+func (h *RoomHub) capacity() int {
+	return h.maxSeats + h.spectatorSlots
+}
+
+// CanAdmit verifica se há vaga para o usuário na sala.
+// Quem já está conectado (reconexão) sempre pode entrar.
+func (h *RoomHub) CanAdmit(userID string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if _, exists := h.clients[userID]; exists {
+		return true
+	}
+	return len(h.clients) < h.capacity()
+}
+
+// participantCount conta os clientes que não são espectadores,
+// ignorando o usuário informado. Deve ser chamado com o mutex travado.
+func (h *RoomHub) participantCount(exceptUserID string) int {
+	count := 0
+	for userID, c := range h.clients {
+		if userID != exceptUserID && !c.IsSpectator() {
+			count++
+		}
+	}
+	return count
+}
+
+// promoteSpectator transforma o espectador mais antigo em participante.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) promoteSpectator() *Client {
+	var oldest *Client
+	for _, c := range h.clients {
+		if c.IsSpectator() && (oldest == nil || c.joinedAt.Before(oldest.joinedAt)) {
+			oldest = c
+		}
+	}
+
+	if oldest != nil {
+		oldest.SetSpectator(false)
+	}
+	return oldest
 }
 
 // sendRoomState envia o estado atual da sala para um cliente.
@@ -312,35 +778,27 @@ func (h *RoomHub) sendRoomState(client *Client) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	roomInfo := RoomInfo{
-		ID:       h.roomID,
-		Name:     h.roomName,
-		Theme:    h.roomTheme,
-		OwnerID:  h.ownerID,
-		MaxSeats: h.maxSeats,
-	}
+	roomInfo := h.roomInfo()
 
 	users := make([]UserInfo, 0, len(h.clients))
 	for _, c := range h.clients {
-		users = append(users, UserInfo{
-			ID:          c.userID,
-			DisplayName: c.displayName,
-			SeatID:      c.GetSeatID(),
-		})
+		users = append(users, userInfo(c))
 	}
 
-	seats := make([]SeatInfo, 0, len(h.seats))
-	i := 0
-	for seatID, userID := range h.seats {
-		seat := SeatInfo{
-			ID:       seatID,
-			Position: i,
-		}
-		if userID != "" {
-			seat.UserID = &userID
-		}
-		seats = append(seats, seat)
-		i++
+	// Assentos na ordem do layout
+	seats := make([]SeatInfo, 0, len(h.layout.Seats))
+	for _, s := range h.layout.Seats {
+		state := h.seatState(s.ID)
+		seats = append(seats, SeatInfo{
+			ID:          s.ID,
+			Position:    s.Position,
+			Row:         s.Row,
+			Column:      s.Column,
+			Kind:        string(s.Kind),
+			UserID:      state.UserID,
+			ReservedFor: state.ReservedFor,
+			HeldUntil:   state.HeldUntil,
+		})
 	}
 
 	// Incluir estado da mídia se existir
@@ -350,21 +808,28 @@ func (h *RoomHub) sendRoomState(client *Client) {
 		mediaState = &stateCopy
 	}
 
+	// Quem pode aprovar entradas já recebe os pedidos pendentes
+	var knocks []KnockPayload
+	if h.can(client, room.PermAdmit) {
+		knocks = h.pendingKnocks()
+	}
+
 	client.Send(NewOutgoingMessage(TypeRoomState, RoomStatePayload{
-		Room:  roomInfo,
-		Users: users,
-		Seats: seats,
-		Media: mediaState,
+		Room:     roomInfo,
+		Users:    users,
+		Seats:    seats,
+		Layout:   layoutInfo(h.layout),
+		Media:    mediaState,
+		Settings: settingsInfo(h.settings),
+		Messages: h.recentMessages(),
+		Knocks:   knocks,
 	}))
 }
 
 // broadcastUserJoined notifica que um usuário entrou.
 func (h *RoomHub) broadcastUserJoined(client *Client) {
 	msg := NewOutgoingMessage(TypeUserJoined, UserJoinedPayload{
-		User: UserInfo{
-			ID:          client.userID,
-			DisplayName: client.displayName,
-		},
+		User: userInfo(client),
 	})
 
 	h.mu.RLock()
@@ -379,20 +844,43 @@ func (h *RoomHub) broadcastUserJoined(client *Client) {
 
 // broadcastUserLeft notifica que um usuário saiu.
 func (h *RoomHub) broadcastUserLeft(userID string) {
-	h.broadcast <- NewOutgoingMessage(TypeUserLeft, UserLeftPayload{
+	h.enqueue(NewOutgoingMessage(TypeUserLeft, UserLeftPayload{
 		UserID: userID,
-	})
+	}))
 }
 
-// broadcastSeatUpdated notifica mudança de assento.
-func (h *RoomHub) broadcastSeatUpdated(seatID string, userID *string) {
-	h.broadcast <- NewOutgoingMessage(TypeSeatUpdated, SeatUpdatedPayload{
-		SeatID: seatID,
-		UserID: userID,
-	})
+// broadcastSeatUpdated notifica mudanças de assento.
+// Os estados devem ser montados com o mutex travado (seatStates).
+func (h *RoomHub) broadcastSeatUpdated(seats ...SeatUpdatedPayload) {
+	for _, seat := range seats {
+		h.enqueue(NewOutgoingMessage(TypeSeatUpdated, seat))
+	}
+}
+
+// checkPartyHosted verifica se a sessão acabou de virar uma festa:
+// o dono está presente junto com pelo menos um convidado.
+// Conta apenas uma vez por sessão. Deve ser chamado com o mutex travado.
+func (h *RoomHub) checkPartyHosted() bool {
+	if h.partyHosted || len(h.clients) < 2 {
+		return false
+	}
+
+	if _, ownerPresent := h.clients[h.ownerID]; !ownerPresent {
+		return false
+	}
+
+	h.partyHosted = true
+	return true
+}
+
+// publish publica um evento de domínio no hub global.
+func (h *RoomHub) publish(e events.Event) {
+	h.globalHub.events.Publish(e)
 }
 
 // GetOwnerID retorna o ID do dono da sala.
 func (h *RoomHub) GetOwnerID() string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
 	return h.ownerID
 }
@@ -0,0 +1,356 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+)
+
+const (
+	// Intervalo da limpeza de assentos guardados e pedidos de troca vencidos
+	seatSweepInterval = 5 * time.Second
+
+	// Tempo que o ocupante tem para responder um pedido de troca
+	seatSwapTTL = 30 * time.Second
+)
+
+// seatHold guarda o assento de quem caiu até o fim do prazo.
+type seatHold struct {
+	userID string
+	until  time.Time
+}
+
+// seatSwap é um pedido de troca de assento aguardando resposta.
+type seatSwap struct {
+	targetID  string
+	fromSeat  string
+	toSeat    string
+	expiresAt time.Time
+}
+
+// seatState monta o estado público de um assento.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) seatState(seatID string) SeatUpdatedPayload {
+	state := SeatUpdatedPayload{SeatID: seatID}
+
+	if userID := h.seats[seatID]; userID != "" {
+		state.UserID = &userID
+	}
+	if reservedFor, ok := h.reserved[seatID]; ok {
+		state.ReservedFor = &reservedFor
+	}
+	if hold, ok := h.holds[seatID]; ok {
+		until := hold.until
+		state.HeldUntil = &until
+	}
+
+	return state
+}
+
+// seatStates monta o estado de vários assentos para broadcast.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) seatStates(seatIDs ...string) []SeatUpdatedPayload {
+	states := make([]SeatUpdatedPayload, 0, len(seatIDs))
+	for _, seatID := range seatIDs {
+		states = append(states, h.seatState(seatID))
+	}
+	return states
+}
+
+// heldSeatOf retorna o assento guardado para o usuário, se houver.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) heldSeatOf(userID string) string {
+	for seatID, hold := range h.holds {
+		if hold.userID == userID {
+			return seatID
+		}
+	}
+	return ""
+}
+
+// heldCount conta os assentos guardados, ignorando o do usuário informado.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) heldCount(exceptUserID string) int {
+	count := 0
+	for _, hold := range h.holds {
+		if hold.userID != exceptUserID {
+			count++
+		}
+	}
+	return count
+}
+
+// reservedSeatOf retorna o assento reservado para o usuário, se houver.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) reservedSeatOf(userID string) string {
+	for seatID, reservedFor := range h.reserved {
+		if reservedFor == userID {
+			return seatID
+		}
+	}
+	return ""
+}
+
+// seatClient coloca o cliente no assento, tirando quem estiver nele.
+// Retorna os assentos alterados. Deve ser chamado com o mutex travado.
+func (h *RoomHub) seatClient(client *Client, seatID string) []string {
+	changed := []string{seatID}
+
+	if occupantID := h.seats[seatID]; occupantID != "" && occupantID != client.userID {
+		if occupant, ok := h.clients[occupantID]; ok && occupant.GetSeatID() == seatID {
+			occupant.SetSeatID("")
+		}
+		delete(h.holds, seatID)
+	}
+
+	if oldSeatID := client.GetSeatID(); oldSeatID != "" && oldSeatID != seatID {
+		h.seats[oldSeatID] = ""
+		changed = append(changed, oldSeatID)
+	}
+
+	h.seats[seatID] = client.userID
+	client.SetSeatID(seatID)
+
+	return changed
+}
+
+// restoreSeat devolve ao cliente que entrou o assento guardado ou reservado para ele.
+// Retorna os assentos alterados. Deve ser chamado com o mutex travado.
+func (h *RoomHub) restoreSeat(client *Client) []string {
+	if seatID := h.heldSeatOf(client.userID); seatID != "" {
+		delete(h.holds, seatID)
+		client.SetSeatID(seatID)
+		return []string{seatID}
+	}
+
+	if client.IsSpectator() {
+		return nil
+	}
+
+	if seatID := h.reservedSeatOf(client.userID); seatID != "" {
+		return h.seatClient(client, seatID)
+	}
+	return nil
+}
+
+// vacateSeat libera o assento de quem saiu, ou o guarda pelo prazo de tolerância.
+// Quem foi expulso ou banido perde o assento na hora.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) vacateSeat(client *Client) (seatID string, held bool) {
+	seatID = client.GetSeatID()
+	if seatID == "" {
+		return "", false
+	}
+
+	if h.seatHoldGrace > 0 && !client.ForfeitsSeat() {
+		h.holds[seatID] = seatHold{
+			userID: client.userID,
+			until:  time.Now().Add(h.seatHoldGrace),
+		}
+		return seatID, true
+	}
+
+	h.seats[seatID] = ""
+	return seatID, false
+}
+
+// cancelSwaps descarta os pedidos de troca feitos para ou pelo usuário.
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) cancelSwaps(userID string) {
+	for requesterID, swap := range h.swaps {
+		if requesterID == userID || swap.targetID == userID {
+			delete(h.swaps, requesterID)
+		}
+	}
+}
+
+// expireSeats libera os assentos guardados vencidos e descarta pedidos de troca antigos.
+func (h *RoomHub) expireSeats() {
+	now := time.Now()
+
+	h.mu.Lock()
+	var freed []string
+	for seatID, hold := range h.holds {
+		if now.After(hold.until) {
+			delete(h.holds, seatID)
+			h.seats[seatID] = ""
+			freed = append(freed, seatID)
+		}
+	}
+
+	for requesterID, swap := range h.swaps {
+		if now.After(swap.expiresAt) {
+			delete(h.swaps, requesterID)
+		}
+	}
+
+	// Cada assento liberado abre lugar para um espectador
+	var promoted []*Client
+	for range freed {
+		c := h.promoteSpectator()
+		if c == nil {
+			break
+		}
+		promoted = append(promoted, c)
+	}
+
+	seatChanges := h.seatStates(freed...)
+	h.mu.Unlock()
+
+	if len(freed) > 0 {
+		log.Printf("Room %s: %d held seat(s) released", h.roomID, len(freed))
+	}
+
+	h.broadcastSeatUpdated(seatChanges...)
+
+	for _, c := range promoted {
+		log.Printf("Room %s: spectator %s promoted to participant", h.roomID, c.userID)
+		h.enqueue(NewOutgoingMessage(TypeUserUpdated, UserUpdatedPayload{
+			User: userInfo(c),
+		}))
+	}
+}
+
+// ReserveSeat reserva um assento para o usuário (vazio remove a reserva).
+// Se ele estiver conectado, já vai para o assento.
+func (h *RoomHub) ReserveSeat(userID, seatID string) {
+	h.mu.Lock()
+
+	var changed []string
+	if oldSeatID := h.reservedSeatOf(userID); oldSeatID != "" && oldSeatID != seatID {
+		delete(h.reserved, oldSeatID)
+		changed = append(changed, oldSeatID)
+	}
+
+	if _, exists := h.seats[seatID]; exists {
+		h.reserved[seatID] = userID
+
+		if client, ok := h.clients[userID]; ok && !client.IsSpectator() {
+			changed = append(changed, h.seatClient(client, seatID)...)
+		} else {
+			changed = append(changed, seatID)
+		}
+	}
+
+	seatChanges := h.seatStates(changed...)
+	h.mu.Unlock()
+
+	if seatID != "" {
+		log.Printf("Room %s: seat %s reserved for user %s", h.roomID, seatID, userID)
+	}
+
+	h.broadcastSeatUpdated(seatChanges...)
+}
+
+// handleSeatSwapRequest pede a troca de assento com quem está no assento escolhido.
+func (h *RoomHub) handleSeatSwapRequest(client *Client, payload json.RawMessage) {
+	var swapPayload SeatSwapRequestPayload
+	if err := json.Unmarshal(payload, &swapPayload); err != nil {
+		client.SendError("INVALID_PAYLOAD", "Invalid seat swap payload")
+		return
+	}
+	toSeat := room.NormalizeSeatID(swapPayload.SeatID)
+
+	h.mu.Lock()
+
+	fromSeat := client.GetSeatID()
+	if fromSeat == "" {
+		h.mu.Unlock()
+		client.SendError("NO_SEAT", "You need a seat to request a swap")
+		return
+	}
+
+	occupantID, exists := h.seats[toSeat]
+	if !exists {
+		h.mu.Unlock()
+		client.SendError("INVALID_SEAT", "Seat does not exist")
+		return
+	}
+
+	occupant, connected := h.clients[occupantID]
+	if occupantID == "" || occupantID == client.userID || !connected || occupant.GetSeatID() != toSeat {
+		h.mu.Unlock()
+		client.SendError("SEAT_NOT_OCCUPIED", "Nobody is sitting in that seat, select it instead")
+		return
+	}
+
+	_, toReserved := h.reserved[toSeat]
+	_, fromReserved := h.reserved[fromSeat]
+	if toReserved || fromReserved {
+		h.mu.Unlock()
+		client.SendError("SEAT_RESERVED", "Reserved seats cannot be swapped")
+		return
+	}
+
+	// Um pedido por vez: o novo substitui o anterior
+	expiresAt := time.Now().Add(seatSwapTTL)
+	h.swaps[client.userID] = seatSwap{
+		targetID:  occupantID,
+		fromSeat:  fromSeat,
+		toSeat:    toSeat,
+		expiresAt: expiresAt,
+	}
+	h.mu.Unlock()
+
+	log.Printf("Room %s: user %s asked %s to swap seats", h.roomID, client.userID, occupantID)
+
+	occupant.Send(NewOutgoingMessage(TypeSeatSwapRequested, SeatSwapRequestedPayload{
+		FromUserID: client.userID,
+		FromSeatID: fromSeat,
+		SeatID:     toSeat,
+		ExpiresAt:  expiresAt,
+	}))
+}
+
+// handleSeatSwapResponse aceita ou recusa um pedido de troca de assento.
+func (h *RoomHub) handleSeatSwapResponse(client *Client, payload json.RawMessage) {
+	var responsePayload SeatSwapResponsePayload
+	if err := json.Unmarshal(payload, &responsePayload); err != nil {
+		client.SendError("INVALID_PAYLOAD", "Invalid seat swap response payload")
+		return
+	}
+
+	h.mu.Lock()
+
+	swap, exists := h.swaps[responsePayload.UserID]
+	if !exists || swap.targetID != client.userID || time.Now().After(swap.expiresAt) {
+		h.mu.Unlock()
+		client.SendError("SWAP_NOT_FOUND", "Seat swap request not found or expired")
+		return
+	}
+	delete(h.swaps, responsePayload.UserID)
+
+	requester, connected := h.clients[responsePayload.UserID]
+
+	if !responsePayload.Accept {
+		h.mu.Unlock()
+		if connected {
+			requester.Send(NewOutgoingMessage(TypeSeatSwapDeclined, SeatSwapDeclinedPayload{
+				UserID: client.userID,
+				SeatID: swap.toSeat,
+			}))
+		}
+		return
+	}
+
+	// Os dois precisam continuar nos assentos do pedido
+	if !connected || requester.GetSeatID() != swap.fromSeat || client.GetSeatID() != swap.toSeat {
+		h.mu.Unlock()
+		client.SendError("SWAP_NOT_FOUND", "Seats changed since the swap was requested")
+		return
+	}
+
+	h.seats[swap.fromSeat] = client.userID
+	h.seats[swap.toSeat] = requester.userID
+	client.SetSeatID(swap.fromSeat)
+	requester.SetSeatID(swap.toSeat)
+
+	seatChanges := h.seatStates(swap.fromSeat, swap.toSeat)
+	h.mu.Unlock()
+
+	log.Printf("Room %s: users %s and %s swapped seats", h.roomID, requester.userID, client.userID)
+
+	h.broadcastSeatUpdated(seatChanges...)
+}
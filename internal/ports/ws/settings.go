@@ -0,0 +1,133 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"time"
+
+	approom "github.com/vinib1903/cineus-api/internal/app/room"
+	"github.com/vinib1903/cineus-api/internal/domain/room"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// linkPattern reconhece links em mensagens de chat (com ou sem protocolo).
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+(\.[a-z0-9-]+)*\.(com|net|org|io|br|tv|gg|me|ly|co|app|dev)\b`)
+
+// UpdateSettings aplica novas configurações na sala e avisa todos os clientes.
+// Ninguém é desconectado: as regras valem a partir da próxima ação.
+func (h *RoomHub) UpdateSettings(settings room.Settings) {
+	h.mu.Lock()
+	h.settings = settings
+	h.mu.Unlock()
+
+	log.Printf("Room %s: settings updated", h.roomID)
+
+	h.enqueue(NewOutgoingMessage(TypeSettingsUpdated, SettingsUpdatedPayload{
+		Settings: settingsInfo(settings),
+	}))
+}
+
+// handleUpdateSettings processa a alteração de configurações pelo dono.
+// As mudanças são salvas pelo serviço de salas sobre as configurações gravadas,
+// que depois as aplica aqui (UpdateSettings) e avisa todos.
+func (h *RoomHub) handleUpdateSettings(client *Client, payload json.RawMessage) {
+	if !h.can(client, room.PermEditSettings) {
+		client.SendError("NOT_ALLOWED", "You are not allowed to change room settings")
+		return
+	}
+
+	var settingsPayload UpdateSettingsPayload
+	if err := json.Unmarshal(payload, &settingsPayload); err != nil {
+		client.SendError("INVALID_PAYLOAD", "Invalid settings payload")
+		return
+	}
+
+	input := approom.UpdateSettingsInput{
+		RoomID:          room.ID(h.roomID),
+		RequesterID:     user.ID(client.userID),
+		SlowModeSeconds: settingsPayload.SlowModeSeconds,
+		ChatEnabled:     settingsPayload.ChatEnabled,
+		LinksAllowed:    settingsPayload.LinksAllowed,
+		GuestsAllowed:   settingsPayload.GuestsAllowed,
+	}
+	if settingsPayload.MediaControl != nil {
+		mediaControl := room.MediaControl(*settingsPayload.MediaControl)
+		input.MediaControl = &mediaControl
+	}
+
+	_, err := h.globalHub.roomService.UpdateSettings(client.ctx, input)
+	switch {
+	case err == nil:
+	case errors.Is(err, room.ErrInvalidSlowMode):
+		client.SendError("INVALID_SETTINGS", "Slow mode must be between 0 and 300 seconds")
+	case errors.Is(err, room.ErrInvalidMediaControl):
+		client.SendError("INVALID_SETTINGS", "Media control must be 'owner', 'hosts' or 'everyone'")
+	case errors.Is(err, approom.ErrNotRoomOwner), errors.Is(err, room.ErrNotOwner):
+		client.SendError("NOT_ALLOWED", "You are not allowed to change room settings")
+	default:
+		log.Printf("Room %s: failed to save settings from %s: %v", h.roomID, client.userID, err)
+		client.SendError("SETTINGS_NOT_SAVED", "Failed to save settings, try again")
+	}
+}
+
+// checkChatLimits aplica as configurações do chat a uma mensagem.
+// Se ela puder ser enviada, marca o horário para o modo lento.
+// Retorna o código e a mensagem do erro (vazios se permitido).
+// Deve ser chamado com o mutex travado.
+func (h *RoomHub) checkChatLimits(client *Client, content string) (string, string) {
+	if h.settings.BypassesChatLimits(client.GetRole()) {
+		return "", ""
+	}
+
+	if !h.settings.ChatEnabled {
+		return "CHAT_DISABLED", "Chat is disabled in this room"
+	}
+
+	if !h.settings.LinksAllowed && linkPattern.MatchString(content) {
+		return "LINKS_NOT_ALLOWED", "Links are not allowed in this room"
+	}
+
+	if slowMode := h.settings.SlowMode(); slowMode > 0 {
+		if wait := slowMode - time.Since(h.lastChatAt[client.userID]); wait > 0 {
+			return "SLOW_MODE", fmt.Sprintf("Slow mode is on, wait %d seconds", int(math.Ceil(wait.Seconds())))
+		}
+	}
+
+	h.lastChatAt[client.userID] = time.Now()
+	return "", ""
+}
+
+// expireChatLimits esquece os horários de mensagens mais antigos que o maior modo lento.
+func (h *RoomHub) expireChatLimits() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for userID, at := range h.lastChatAt {
+		if time.Since(at) > room.MaxSlowModeSeconds*time.Second {
+			delete(h.lastChatAt, userID)
+		}
+	}
+}
+
+// canControlMedia verifica se o cliente pode controlar o player
+// com as configurações atuais da sala.
+func (h *RoomHub) canControlMedia(client *Client) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.settings.CanControlMedia(client.GetRole())
+}
+
+// settingsInfo monta as configurações da sala para os clientes.
+func settingsInfo(s room.Settings) RoomSettingsInfo {
+	return RoomSettingsInfo{
+		SlowModeSeconds: s.SlowModeSeconds,
+		ChatEnabled:     s.ChatEnabled,
+		LinksAllowed:    s.LinksAllowed,
+		MediaControl:    string(s.MediaControl),
+		GuestsAllowed:   s.GuestsAllowed,
+	}
+}
@@ -0,0 +1,203 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/vinib1903/cineus-api/internal/app/notification"
+	"github.com/vinib1903/cineus-api/internal/domain/chat"
+	"github.com/vinib1903/cineus-api/internal/domain/user"
+)
+
+// UserHub gerencia as conexões pessoais dos usuários (fora das salas).
+// Entrega mensagens diretas, confirmações de leitura e notificações em tempo real.
+type UserHub struct {
+	// Conexões abertas: userID -> conexões (o usuário pode ter várias abas/aparelhos)
+	conns map[string]map[*userConn]bool
+
+	// Mutex para proteger o mapa
+	mu sync.RWMutex
+}
+
+// userConn é uma conexão pessoal de um usuário.
+// O cliente só recebe: mensagens enviadas por ele são ignoradas.
+type userConn struct {
+	userID string
+	conn   *websocket.Conn
+	send   chan []byte
+
+	// Contexto para cancelamento
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewUserHub cria um novo hub de conexões pessoais.
+func NewUserHub() *UserHub {
+	return &UserHub{
+		conns: make(map[string]map[*userConn]bool),
+	}
+}
+
+// Serve mantém a conexão pessoal do usuário aberta (bloqueia até desconectar).
+func (h *UserHub) Serve(conn *websocket.Conn, userID string) {
+	// CloseRead descarta o que o cliente enviar e cancela o contexto quando ele fecha
+	ctx, cancel := context.WithCancel(conn.CloseRead(context.Background()))
+
+	c := &userConn{
+		userID: userID,
+		conn:   conn,
+		send:   make(chan []byte, sendBufferSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	h.add(c)
+	defer func() {
+		h.remove(c)
+		cancel()
+		conn.Close(websocket.StatusNormalClosure, "connection closed")
+	}()
+
+	c.writePump()
+}
+
+// add registra uma conexão.
+func (h *UserHub) add(c *userConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.conns[c.userID] == nil {
+		h.conns[c.userID] = make(map[*userConn]bool)
+	}
+	h.conns[c.userID][c] = true
+
+	log.Printf("UserHub: user %s connected (connections: %d)", c.userID, len(h.conns[c.userID]))
+}
+
+// remove tira uma conexão do hub.
+func (h *UserHub) remove(c *userConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.conns[c.userID], c)
+	if len(h.conns[c.userID]) == 0 {
+		delete(h.conns, c.userID)
+	}
+
+	log.Printf("UserHub: user %s disconnected", c.userID)
+}
+
+// sendToUser envia uma mensagem para todas as conexões do usuário.
+// Retorna true se ao menos uma conexão recebeu a mensagem.
+func (h *UserHub) sendToUser(userID string, msg *OutgoingMessage) bool {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("UserHub: failed to marshal message: %v", err)
+		return false
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := false
+	for c := range h.conns[userID] {
+		// Não bloqueia se o buffer estiver cheio: o cliente está muito lento
+		select {
+		case c.send <- data:
+			delivered = true
+		default:
+			log.Printf("UserHub: send buffer full for user %s, closing connection", userID)
+			c.cancel()
+		}
+	}
+	return delivered
+}
+
+// PushDirectMessage entrega uma mensagem direta ao destinatário
+// e às conexões do remetente (outras abas/aparelhos).
+// Implementa appchat.Pusher.
+func (h *UserHub) PushDirectMessage(dm *chat.DirectMessage) {
+	msg := NewOutgoingMessage(TypeDirectMessage, DirectMessagePayload{
+		ID:         string(dm.ID),
+		FromUserID: string(dm.FromUserID),
+		ToUserID:   string(dm.ToUserID),
+		Content:    dm.Content,
+		CreatedAt:  dm.CreatedAt,
+	})
+
+	h.sendToUser(string(dm.ToUserID), msg)
+	h.sendToUser(string(dm.FromUserID), msg)
+}
+
+// PushDirectMessagesRead avisa o remetente e quem leu que a conversa foi lida.
+// Implementa appchat.Pusher.
+func (h *UserHub) PushDirectMessagesRead(senderID, readerID user.ID, readAt time.Time) {
+	msg := NewOutgoingMessage(TypeDirectMessagesRead, DirectMessagesReadPayload{
+		SenderID: string(senderID),
+		ReaderID: string(readerID),
+		ReadAt:   readAt,
+	})
+
+	h.sendToUser(string(senderID), msg)
+	h.sendToUser(string(readerID), msg)
+}
+
+// PushToUser entrega uma notificação nas conexões pessoais do usuário.
+// Implementa notification.Pusher.
+func (h *UserHub) PushToUser(userID user.ID, n notification.Notification) bool {
+	return h.sendToUser(string(userID), NewOutgoingMessage(MessageType(n.Type), NotificationPayload{
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		CreatedAt: n.CreatedAt,
+	}))
+}
+
+// ConnectionCount retorna o número de conexões pessoais abertas.
+func (h *UserHub) ConnectionCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	total := 0
+	for _, conns := range h.conns {
+		total += len(conns)
+	}
+	return total
+}
+
+// writePump envia as mensagens do canal para o WebSocket e mantém a conexão viva.
+func (c *userConn) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+
+		case message := <-c.send:
+			ctx, cancel := context.WithTimeout(c.ctx, writeWait)
+			err := c.conn.Write(ctx, websocket.MessageText, message)
+			cancel()
+
+			if err != nil {
+				log.Printf("UserHub: write error for user %s: %v", c.userID, err)
+				return
+			}
+
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(c.ctx, writeWait)
+			err := c.conn.Ping(ctx)
+			cancel()
+
+			if err != nil {
+				log.Printf("UserHub: ping error for user %s: %v", c.userID, err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,5 @@
+-- Extensão para busca aproximada por trigramas
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Índice trigram para busca de usuários por nome
+CREATE INDEX idx_users_display_name_trgm ON users USING GIN (display_name gin_trgm_ops);
@@ -0,0 +1,21 @@
+-- Progresso dos usuários (contadores por métrica)
+CREATE TABLE user_stats (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    metric VARCHAR(50) NOT NULL,
+    value BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, metric)
+);
+
+-- Conquistas liberadas
+CREATE TABLE user_achievements (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    achievement_id VARCHAR(50) NOT NULL,
+    unlocked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, achievement_id)
+);
+
+-- Índice para listar conquistas de um usuário
+CREATE INDEX idx_user_achievements_user_id ON user_achievements(user_id, unlocked_at DESC);
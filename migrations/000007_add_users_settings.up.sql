@@ -0,0 +1,2 @@
+-- Preferências do usuário (documento JSON)
+ALTER TABLE users ADD COLUMN settings JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,15 @@
+-- Membros das salas e seus papéis
+-- O dono não tem registro aqui (vem de rooms.owner_id)
+CREATE TABLE room_members (
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'member'
+        CHECK (role IN ('co_host', 'moderator', 'member')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (room_id, user_id)
+);
+
+-- Índice para listar as salas de um usuário
+CREATE INDEX idx_room_members_user_id ON room_members(user_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_rooms_public_theme;
+DROP INDEX IF EXISTS idx_rooms_name_trgm;
@@ -0,0 +1,6 @@
+-- Índice trigram para busca de salas por nome
+CREATE INDEX idx_rooms_name_trgm ON rooms USING GIN (name gin_trgm_ops);
+
+-- Índice para listagem de salas públicas por tema
+CREATE INDEX idx_rooms_public_theme ON rooms(theme, created_at DESC)
+    WHERE visibility = 'public' AND deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- Transferências de posse pendentes (no máximo uma por sala)
+CREATE TABLE room_transfers (
+    room_id UUID PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Índice para listar as ofertas recebidas por um usuário
+CREATE INDEX idx_room_transfers_to_user_id ON room_transfers(to_user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS room_event_rsvps;
+DROP TABLE IF EXISTS room_events;
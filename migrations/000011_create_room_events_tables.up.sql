@@ -0,0 +1,32 @@
+-- Sessões agendadas nas salas
+CREATE TABLE room_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    media_url TEXT NOT NULL DEFAULT '',
+    media_title VARCHAR(200) NOT NULL DEFAULT '',
+    reminder_sent_at TIMESTAMP WITH TIME ZONE,
+    started_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Índice para listar as sessões de uma sala
+CREATE INDEX idx_room_events_room_starts ON room_events(room_id, starts_at);
+
+-- Índice para o agendador encontrar sessões pendentes
+CREATE INDEX idx_room_events_pending ON room_events(starts_at) WHERE started_at IS NULL;
+
+-- Respostas (RSVP) às sessões
+CREATE TABLE room_event_rsvps (
+    event_id UUID NOT NULL REFERENCES room_events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL
+        CHECK (status IN ('going', 'maybe', 'declined')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (event_id, user_id)
+);
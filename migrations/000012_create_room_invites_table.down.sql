@@ -0,0 +1,3 @@
+ALTER TABLE room_members DROP COLUMN IF EXISTS seat_id;
+
+DROP TABLE IF EXISTS room_invites;
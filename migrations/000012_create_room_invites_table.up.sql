@@ -0,0 +1,19 @@
+-- Convites para salas
+CREATE TABLE room_invites (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    max_uses INTEGER NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    uses INTEGER NOT NULL DEFAULT 0 CHECK (uses >= 0),
+    seat_id VARCHAR(10),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Índice para listar os convites de uma sala
+CREATE INDEX idx_room_invites_room_id ON room_invites(room_id, created_at DESC);
+
+-- Assento reservado para o membro (vindo de um convite)
+ALTER TABLE room_members ADD COLUMN seat_id VARCHAR(10);
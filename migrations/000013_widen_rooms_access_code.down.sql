@@ -0,0 +1,5 @@
+-- Códigos com mais de 10 caracteres não cabem na coluna antiga:
+-- são cortados nos 10 primeiros (o dono precisa compartilhar o código novo)
+UPDATE rooms SET access_code = LEFT(access_code, 10) WHERE LENGTH(access_code) > 10;
+
+ALTER TABLE rooms ALTER COLUMN access_code TYPE VARCHAR(10);
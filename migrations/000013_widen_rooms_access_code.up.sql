@@ -0,0 +1,2 @@
+-- Códigos de acesso mais longos (comprimento configurável)
+ALTER TABLE rooms ALTER COLUMN access_code TYPE VARCHAR(32);
@@ -0,0 +1,13 @@
+CREATE TYPE room_theme AS ENUM ('default', 'farm', 'horror', 'fun', 'space');
+
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_theme_fkey;
+
+-- Salas com temas criados depois voltam para o padrão
+UPDATE rooms SET theme = 'default'
+    WHERE theme NOT IN ('default', 'farm', 'horror', 'fun', 'space');
+
+ALTER TABLE rooms ALTER COLUMN theme DROP DEFAULT;
+ALTER TABLE rooms ALTER COLUMN theme TYPE room_theme USING theme::room_theme;
+ALTER TABLE rooms ALTER COLUMN theme SET DEFAULT 'default';
+
+DROP TABLE IF EXISTS themes;
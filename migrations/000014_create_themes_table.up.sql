@@ -0,0 +1,30 @@
+-- Catálogo de temas (substitui o enum room_theme)
+CREATE TABLE themes (
+    id VARCHAR(32) PRIMARY KEY,
+    display_name VARCHAR(50) NOT NULL,
+    assets JSONB NOT NULL DEFAULT '{}',
+    palette JSONB NOT NULL DEFAULT '{}',
+    seat_columns INTEGER NOT NULL DEFAULT 8 CHECK (seat_columns BETWEEN 2 AND 20),
+    seat_aisles INTEGER[] NOT NULL DEFAULT '{}',
+    unlock_achievement VARCHAR(50),
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Temas que já existiam no enum
+INSERT INTO themes (id, display_name, palette, seat_columns, seat_aisles, sort_order) VALUES
+    ('default', 'Cinema', '{"primary": "#B71C1C", "background": "#121212", "accent": "#FFD54F"}', 8, '{4}', 0),
+    ('farm', 'Farm', '{"primary": "#6D4C41", "background": "#F1E3C6", "accent": "#7CB342"}', 6, '{3}', 1),
+    ('horror', 'Horror', '{"primary": "#4A0E0E", "background": "#0B0B0B", "accent": "#8B0000"}', 8, '{2,6}', 2),
+    ('fun', 'Fun', '{"primary": "#FF4081", "background": "#FFF8E1", "accent": "#00BCD4"}', 10, '{}', 3),
+    ('space', 'Space', '{"primary": "#1A237E", "background": "#05051A", "accent": "#B388FF"}', 6, '{2,4}', 4);
+
+-- Salas passam a referenciar o catálogo
+ALTER TABLE rooms ALTER COLUMN theme DROP DEFAULT;
+ALTER TABLE rooms ALTER COLUMN theme TYPE VARCHAR(32) USING theme::text;
+ALTER TABLE rooms ALTER COLUMN theme SET DEFAULT 'default';
+ALTER TABLE rooms ADD CONSTRAINT rooms_theme_fkey
+    FOREIGN KEY (theme) REFERENCES themes(id) ON UPDATE CASCADE;
+
+DROP TYPE room_theme;
@@ -0,0 +1,2 @@
+-- Administradores da plataforma (gerenciam o catálogo de temas)
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
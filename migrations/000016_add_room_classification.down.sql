@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_rooms_language;
+DROP INDEX IF EXISTS idx_rooms_category;
+DROP INDEX IF EXISTS idx_rooms_tags;
+
+ALTER TABLE rooms
+    DROP COLUMN IF EXISTS content_rating,
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS category,
+    DROP COLUMN IF EXISTS tags;
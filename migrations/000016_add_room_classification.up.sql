@@ -0,0 +1,12 @@
+-- Classificação das salas: tags, categoria, idioma e faixa etária
+ALTER TABLE rooms
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT '',
+    ADD COLUMN content_rating VARCHAR(4) NOT NULL DEFAULT 'all'
+        CHECK (content_rating IN ('all', '12+', '16+', '18+'));
+
+-- Filtros da listagem pública
+CREATE INDEX idx_rooms_tags ON rooms USING GIN (tags);
+CREATE INDEX idx_rooms_category ON rooms(category) WHERE deleted_at IS NULL;
+CREATE INDEX idx_rooms_language ON rooms(language) WHERE deleted_at IS NULL;
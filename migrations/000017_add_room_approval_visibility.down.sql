@@ -0,0 +1,18 @@
+-- Salas com aprovação voltam a ser públicas
+UPDATE rooms SET visibility = 'public' WHERE visibility = 'approval';
+
+DROP INDEX IF EXISTS idx_rooms_public_theme;
+DROP INDEX IF EXISTS idx_rooms_public_active;
+
+-- O Postgres não remove valores de enum: recriar o tipo
+ALTER TABLE rooms ALTER COLUMN visibility DROP DEFAULT;
+ALTER TYPE room_visibility RENAME TO room_visibility_old;
+CREATE TYPE room_visibility AS ENUM ('public', 'private');
+ALTER TABLE rooms ALTER COLUMN visibility TYPE room_visibility USING visibility::text::room_visibility;
+ALTER TABLE rooms ALTER COLUMN visibility SET DEFAULT 'public';
+DROP TYPE room_visibility_old;
+
+CREATE INDEX idx_rooms_public_active ON rooms(visibility, deleted_at)
+    WHERE visibility = 'public' AND deleted_at IS NULL;
+CREATE INDEX idx_rooms_public_theme ON rooms(theme, created_at DESC)
+    WHERE visibility = 'public' AND deleted_at IS NULL;
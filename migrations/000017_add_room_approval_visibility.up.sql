@@ -0,0 +1,11 @@
+-- Salas com aprovação: aparecem na listagem, mas a entrada depende do dono ou da moderação
+ALTER TYPE room_visibility ADD VALUE IF NOT EXISTS 'approval';
+
+-- Índices da listagem passam a cobrir as salas com aprovação
+DROP INDEX IF EXISTS idx_rooms_public_active;
+CREATE INDEX idx_rooms_public_active ON rooms(visibility, deleted_at)
+    WHERE visibility <> 'private' AND deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_rooms_public_theme;
+CREATE INDEX idx_rooms_public_theme ON rooms(theme, created_at DESC)
+    WHERE visibility <> 'private' AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_room_audit_log_immutable ON room_audit_log;
+DROP FUNCTION IF EXISTS room_audit_log_immutable();
+DROP TABLE IF EXISTS room_audit_log;
@@ -0,0 +1,28 @@
+-- Log de auditoria da moderação das salas (só inclusões)
+CREATE TABLE room_audit_log (
+    id UUID PRIMARY KEY,
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    action VARCHAR(32) NOT NULL,
+    actor_id UUID NOT NULL,
+    target_id UUID,
+    reason VARCHAR(200),
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Consulta por sala e visão geral da plataforma (mais recentes primeiro)
+CREATE INDEX idx_room_audit_log_room ON room_audit_log(room_id, created_at DESC, id DESC);
+CREATE INDEX idx_room_audit_log_created_at ON room_audit_log(created_at DESC, id DESC);
+CREATE INDEX idx_room_audit_log_actor ON room_audit_log(actor_id);
+CREATE INDEX idx_room_audit_log_target ON room_audit_log(target_id) WHERE target_id IS NOT NULL;
+
+-- Registros não podem ser alterados (a remoção só acontece junto com a sala)
+CREATE FUNCTION room_audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'room_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_room_audit_log_immutable
+    BEFORE UPDATE ON room_audit_log
+    FOR EACH ROW EXECUTE FUNCTION room_audit_log_immutable();
@@ -0,0 +1,2 @@
+-- Configurações da sala (documento JSON; campos ausentes usam o padrão)
+ALTER TABLE rooms ADD COLUMN settings JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_room_members_seat;
@@ -0,0 +1,16 @@
+-- Desfazer reservas duplicadas de um mesmo assento (fica a mais antiga)
+UPDATE room_members
+SET seat_id = NULL
+WHERE (room_id, user_id) IN (
+    SELECT room_id, user_id
+    FROM (
+        SELECT room_id, user_id,
+               ROW_NUMBER() OVER (PARTITION BY room_id, seat_id ORDER BY updated_at, user_id) AS position
+        FROM room_members
+        WHERE seat_id IS NOT NULL
+    ) ranked
+    WHERE position > 1
+);
+
+-- Um assento só pode estar reservado para um membro da sala
+CREATE UNIQUE INDEX idx_room_members_seat ON room_members(room_id, seat_id) WHERE seat_id IS NOT NULL;
@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS trg_room_audit_log_immutable ON room_audit_log;
+
+CREATE TRIGGER trg_room_audit_log_immutable
+    BEFORE UPDATE ON room_audit_log
+    FOR EACH ROW EXECUTE FUNCTION room_audit_log_immutable();
+
+-- Registros de salas que já foram apagadas não cabem na chave estrangeira
+DELETE FROM room_audit_log WHERE room_id NOT IN (SELECT id FROM rooms);
+
+ALTER TABLE room_audit_log
+    ADD CONSTRAINT room_audit_log_room_id_fkey
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE;
@@ -0,0 +1,9 @@
+-- O log de auditoria sobrevive à limpeza das salas: room_id vira uma coluna simples
+ALTER TABLE room_audit_log DROP CONSTRAINT room_audit_log_room_id_fkey;
+
+-- Registros também não podem ser removidos
+DROP TRIGGER trg_room_audit_log_immutable ON room_audit_log;
+
+CREATE TRIGGER trg_room_audit_log_immutable
+    BEFORE UPDATE OR DELETE ON room_audit_log
+    FOR EACH ROW EXECUTE FUNCTION room_audit_log_immutable();